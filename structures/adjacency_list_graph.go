@@ -2,12 +2,15 @@ package structures
 
 import (
 	"fmt"
+	"slices"
 )
 
 // adjacencyListGraph represents a weighted graph implemented using an adjacency list.
 type adjacencyListGraph[T comparable, W Numeric] struct {
-	adjList  map[T]map[T]W
-	directed bool
+	adjList      map[T]map[T]W
+	order        []T // vertices in insertion order
+	directed     bool
+	negativeArcs int // stored arcs with a negative weight, mirrored ones included
 }
 
 // NewAdjacencyListGraph creates a new weighted adjacency list graph.
@@ -24,6 +27,7 @@ func (g *adjacencyListGraph[T, W]) AddVertex(vertex T) error {
 		return fmt.Errorf("%w: %v", ErrVertexAlreadyExists, vertex)
 	}
 	g.adjList[vertex] = make(map[T]W)
+	g.order = append(g.order, vertex)
 	return nil
 }
 
@@ -32,9 +36,14 @@ func (g *adjacencyListGraph[T, W]) RemoveVertex(vertex T) error {
 	if _, exists := g.adjList[vertex]; !exists {
 		return ErrVertexNotFound
 	}
+	for to := range g.adjList[vertex] {
+		g.deleteArc(vertex, to)
+	}
 	delete(g.adjList, vertex)
-	for _, neighbors := range g.adjList {
-		delete(neighbors, vertex)
+	i := slices.Index(g.order, vertex)
+	g.order = slices.Delete(g.order, i, i+1)
+	for from := range g.adjList {
+		g.deleteArc(from, vertex)
 	}
 	return nil
 }
@@ -47,7 +56,10 @@ func (g *adjacencyListGraph[T, W]) AddEdge(from, to T, weight W) error {
 	if _, exists := g.adjList[to]; !exists {
 		return ErrVertexNotFound
 	}
-	g.adjList[from][to] = weight
+	g.setArc(from, to, weight)
+	if !g.directed {
+		g.setArc(to, from, weight)
+	}
 	return nil
 }
 
@@ -59,10 +71,37 @@ func (g *adjacencyListGraph[T, W]) RemoveEdge(from, to T) error {
 	if _, exists := g.adjList[from][to]; !exists {
 		return ErrEdgeNotFound
 	}
-	delete(g.adjList[from], to)
+	g.deleteArc(from, to)
+	if !g.directed {
+		g.deleteArc(to, from)
+	}
 	return nil
 }
 
+// setArc stores the weight of the arc from a vertex to another, counting negative weights.
+func (g *adjacencyListGraph[T, W]) setArc(from, to T, weight W) {
+	if old, exists := g.adjList[from][to]; exists && old < 0 {
+		g.negativeArcs--
+	}
+	if weight < 0 {
+		g.negativeArcs++
+	}
+	g.adjList[from][to] = weight
+}
+
+// deleteArc deletes the arc from a vertex to another if it exists, counting negative weights.
+func (g *adjacencyListGraph[T, W]) deleteArc(from, to T) {
+	if old, exists := g.adjList[from][to]; exists && old < 0 {
+		g.negativeArcs--
+	}
+	delete(g.adjList[from], to)
+}
+
+// hasNegativeWeight checks if an edge has a negative weight.
+func (g *adjacencyListGraph[T, W]) hasNegativeWeight() bool {
+	return g.negativeArcs > 0
+}
+
 // HasEdge checks if there is an edge between two vertices.
 func (g *adjacencyListGraph[T, W]) HasEdge(from, to T) bool {
 	_, exists := g.adjList[from][to]
@@ -90,27 +129,31 @@ func (g *adjacencyListGraph[T, W]) Weight(from, to T) (W, error) {
 	return weight, nil
 }
 
-// Vertices returns all vertices in the graph.
+// Vertices returns all vertices in the graph in insertion order.
 func (g *adjacencyListGraph[T, W]) Vertices() []T {
-	vertices := make([]T, 0, len(g.adjList))
-	for vertex := range g.adjList {
-		vertices = append(vertices, vertex)
-	}
-	return vertices
+	return slices.Clone(g.order)
 }
 
 // Edges returns all edges in the graph with their weights.
+// In an undirected graph every edge is reported once.
 func (g *adjacencyListGraph[T, W]) Edges() []Edge[T, W] {
 	var edges []Edge[T, W]
-	for from, neighbors := range g.adjList {
-		for to, weight := range neighbors {
-			edges = append(edges, Edge[T, W]{From: from, To: to, Weight: weight})
+	reported := make(map[Edge[T, W]]bool)
+	for _, from := range g.order {
+		for to, weight := range g.adjList[from] {
+			if !g.directed && reported[Edge[T, W]{From: to, To: from, Weight: weight}] {
+				continue
+			}
+			edge := Edge[T, W]{From: from, To: to, Weight: weight}
+			reported[edge] = true
+			edges = append(edges, edge)
 		}
 	}
 	return edges
 }
 
 // Degree returns the out-degree of a vertex.
+// In an undirected graph it is the number of incident edges, a self-loop counts once.
 func (g *adjacencyListGraph[T, W]) Degree(vertex T) (int, error) {
 	neighbors, exists := g.adjList[vertex]
 	if !exists {
@@ -120,10 +163,14 @@ func (g *adjacencyListGraph[T, W]) Degree(vertex T) (int, error) {
 }
 
 // InDegree returns the in-degree of a vertex.
+// In an undirected graph it is the same as the degree.
 func (g *adjacencyListGraph[T, W]) InDegree(vertex T) (int, error) {
 	if _, exists := g.adjList[vertex]; !exists {
 		return 0, ErrVertexNotFound
 	}
+	if !g.directed {
+		return len(g.adjList[vertex]), nil
+	}
 	inDegree := 0
 	for _, neighbors := range g.adjList {
 		if _, exists := neighbors[vertex]; exists {
@@ -134,12 +181,14 @@ func (g *adjacencyListGraph[T, W]) InDegree(vertex T) (int, error) {
 }
 
 // Transpose returns the transposed graph (reverses all edges).
+// The transpose of an undirected graph is a copy of it.
 func (g *adjacencyListGraph[T, W]) Transpose() Graph[T, W] {
 	transposed := NewAdjacencyListGraph[T, W](g.directed)
+	for _, vertex := range g.order {
+		transposed.AddVertex(vertex)
+	}
 	for from, neighbors := range g.adjList {
 		for to, weight := range neighbors {
-			transposed.AddVertex(from)
-			transposed.AddVertex(to)
 			transposed.AddEdge(to, from, weight)
 		}
 	}
@@ -151,64 +200,47 @@ func (g *adjacencyListGraph[T, W]) IsDirected() bool {
 	return g.directed
 }
 
-// ShortestPath implements Dijkstra's algorithm to find the shortest path.
-func (g *adjacencyListGraph[T, W]) ShortestPath(from, to T) ([]T, error) {
-	// Check if the start and end vertices exist.
-	if _, exists := g.adjList[from]; !exists {
-		return nil, ErrVertexNotFound
-	}
-	if _, exists := g.adjList[to]; !exists {
-		return nil, ErrVertexNotFound
-	}
-
-	// Initialize the distance map, previous vertex map, and priority queue.
-	distances := make(map[T]W)
-	previous := make(map[T]*T)
+// ShortestPath implements Dijkstra's algorithm to find the shortest path and its cost.
+func (g *adjacencyListGraph[T, W]) ShortestPath(from, to T) ([]T, W, error) {
+	return shortestPath[T, W](g, from, to)
+}
 
-	// Create a comparison function for the priority queue.
-	compare := func(a, b T) int {
-		if distances[a] < distances[b] {
-			return -1
-		} else if distances[a] > distances[b] {
-			return 1
-		}
-		return 0
-	}
+// ShortestPathTree implements Dijkstra's algorithm to find the shortest paths from a vertex
+// to every vertex, so they can be queried many times.
+func (g *adjacencyListGraph[T, W]) ShortestPathTree(from T) (*ShortestPathTree[T, W], error) {
+	return shortestPathTree[T, W](g, from, nil)
+}
 
-	pq := NewPriorityQueue(compare)
+// MarshalJSON encodes the graph with its directedness and weight type, listing the
+// vertices in the order they were added.
+func (g *adjacencyListGraph[T, W]) MarshalJSON() ([]byte, error) {
+	return marshalGraphJSON[T, W](g, g.order)
+}
 
-	// Initialize all distances to infinity.
-	infinity := NumericMaxValue[W]()
-	for vertex := range g.adjList {
-		distances[vertex] = infinity
+// UnmarshalJSON replaces the graph with one encoded by MarshalJSON, taking its directedness.
+// If the data has another schema version or weight type, retrieve an ErrGraphSchemaVersion
+// or an ErrGraphWeightType; if it is malformed, an ErrInvalidGraphData.
+func (g *adjacencyListGraph[T, W]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalGraphJSON(data, NewAdjacencyListGraph[T, W])
+	if err != nil {
+		return err
 	}
-	distances[from] = NumericZeroValue[W]() // Distance to itself is zero.
-
-	// Add the starting vertex to the priority queue.
-	pq.Push(from)
-
-	// Dijkstra's algorithm loop.
-	for pq.Size() > 0 {
-		current, err := pq.Pop()
-		if err != nil {
-			return nil, fmt.Errorf("%w: error popping from priority queue: %w", ErrFindingShortestPath, err)
-		}
+	*g = *decoded.(*adjacencyListGraph[T, W])
+	return nil
+}
 
-		// If we reached the target vertex, reconstruct the path.
-		if current == to {
-			return reconstructPathOfGraph(previous, to), nil
-		}
+// MarshalBinary encodes the graph like MarshalJSON in the compact format of encoding/gob.
+func (g *adjacencyListGraph[T, W]) MarshalBinary() ([]byte, error) {
+	return marshalGraphBinary[T, W](g, g.order)
+}
 
-		// Explore all neighbors of the current vertex.
-		for neighbor, weight := range g.adjList[current] {
-			alt := distances[current] + weight
-			if alt < distances[neighbor] {
-				distances[neighbor] = alt
-				previous[neighbor] = &current
-				pq.Push(neighbor)
-			}
-		}
+// UnmarshalBinary replaces the graph with one encoded by MarshalBinary, taking its
+// directedness. It fails like UnmarshalJSON.
+func (g *adjacencyListGraph[T, W]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalGraphBinary(data, NewAdjacencyListGraph[T, W])
+	if err != nil {
+		return err
 	}
-
-	return nil, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
+	*g = *decoded.(*adjacencyListGraph[T, W])
+	return nil
 }
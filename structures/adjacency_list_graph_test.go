@@ -1,6 +1,7 @@
 package structures_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/Jibaru/golang-data-structures/structures"
@@ -237,10 +238,13 @@ func TestAdjacencyListGraph_ShortestPath(t *testing.T) {
 	g.AddEdge("A", "B", 1)
 	g.AddEdge("B", "C", 2)
 
-	path, err := g.ShortestPath("A", "C")
+	path, cost, err := g.ShortestPath("A", "C")
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
+	if cost != 3 {
+		t.Errorf("expected cost 3, got %d", cost)
+	}
 	expectedPath := []string{"A", "B", "C"}
 	for i := range path {
 		if path[i] != expectedPath[i] {
@@ -248,8 +252,111 @@ func TestAdjacencyListGraph_ShortestPath(t *testing.T) {
 		}
 	}
 
-	_, err = g.ShortestPath("A", "D")
+	_, _, err = g.ShortestPath("A", "D")
 	if err == nil {
 		t.Errorf("expected error for no path")
 	}
 }
+
+// checkShortestPathsAgainstReference compares the cost of the paths found by
+// ShortestPath on random graphs with the distances of Floyd-Warshall
+func checkShortestPathsAgainstReference(t *testing.T, newGraph func() structures.Graph[int, int]) {
+	t.Helper()
+
+	const n = 8
+	const infinity = 1 << 30
+
+	for seed := int64(0); seed < 200; seed++ {
+		rnd := rand.New(rand.NewSource(seed))
+		g := newGraph()
+		distances := make([][]int, n)
+		for i := range distances {
+			g.AddVertex(i)
+			distances[i] = make([]int, n)
+			for j := range distances[i] {
+				if i != j {
+					distances[i][j] = infinity
+				}
+			}
+		}
+
+		for k := 0; k < 20; k++ {
+			from, to, weight := rnd.Intn(n), rnd.Intn(n), rnd.Intn(5)+1
+			if from == to || g.HasEdge(from, to) {
+				continue
+			}
+			g.AddEdge(from, to, weight)
+			distances[from][to] = weight
+		}
+
+		for k := 0; k < n; k++ {
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if distances[i][k]+distances[k][j] < distances[i][j] {
+						distances[i][j] = distances[i][k] + distances[k][j]
+					}
+				}
+			}
+		}
+
+		for to := 1; to < n; to++ {
+			path, reported, err := g.ShortestPath(0, to)
+			if distances[0][to] == infinity {
+				if err == nil {
+					t.Fatalf("seed %d: expected no path to %d, got %v", seed, to, path)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("seed %d: expected path to %d, got error %v", seed, to, err)
+			}
+
+			cost := 0
+			for i := 1; i < len(path); i++ {
+				weight, err := g.Weight(path[i-1], path[i])
+				if err != nil {
+					t.Fatalf("seed %d: path %v uses a missing edge", seed, path)
+				}
+				cost += weight
+			}
+			if path[0] != 0 || path[len(path)-1] != to || cost != distances[0][to] {
+				t.Fatalf("seed %d: expected cost %d to %d, got %v with cost %d", seed, distances[0][to], to, path, cost)
+			}
+			if reported != cost {
+				t.Fatalf("seed %d: expected reported cost %d to %d, got %d", seed, cost, to, reported)
+			}
+		}
+	}
+}
+
+// TestAdjacencyListGraph_ShortestPath_EqualDistances covers vertices reaching the same
+// distance, which the previous priority queue merged into a single element
+func TestAdjacencyListGraph_ShortestPath_EqualDistances(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		g := structures.NewAdjacencyListGraph[int, int](true)
+		for v := 0; v < 5; v++ {
+			g.AddVertex(v)
+		}
+		g.AddEdge(0, 4, 7)
+		g.AddEdge(0, 1, 7)
+		g.AddEdge(1, 2, 5)
+		g.AddEdge(1, 3, 4)
+		g.AddEdge(2, 1, 2)
+		g.AddEdge(2, 4, 5)
+		g.AddEdge(4, 3, 9)
+
+		path, _, err := g.ShortestPath(0, 4)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(path) != 2 || path[0] != 0 || path[1] != 4 {
+			t.Fatalf("expected [0 4], got %v", path)
+		}
+	}
+}
+
+func TestAdjacencyListGraph_ShortestPath_RandomGraphs(t *testing.T) {
+	checkShortestPathsAgainstReference(t, func() structures.Graph[int, int] {
+		return structures.NewAdjacencyListGraph[int, int](true)
+	})
+}
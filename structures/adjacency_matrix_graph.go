@@ -6,10 +6,11 @@ import (
 
 // adjacencyMatrixGraph represents a weighted graph implemented using an adjacency matrix.
 type adjacencyMatrixGraph[T comparable, W Numeric] struct {
-	vertices []T
-	matrix   [][]*W
-	index    map[T]int
-	directed bool
+	vertices     []T
+	matrix       [][]*W
+	index        map[T]int
+	directed     bool
+	negativeArcs int // stored arcs with a negative weight, mirrored ones included
 }
 
 // NewAdjacencyMatrixGraph creates a new weighted adjacency matrix graph.
@@ -42,6 +43,10 @@ func (g *adjacencyMatrixGraph[T, W]) RemoveVertex(vertex T) error {
 	if !exists {
 		return ErrVertexNotFound
 	}
+	for i := range g.vertices {
+		g.setArc(idx, i, nil)
+		g.setArc(i, idx, nil)
+	}
 	// Remove vertex from matrix
 	g.matrix = append(g.matrix[:idx], g.matrix[idx+1:]...)
 	for i := range g.matrix {
@@ -63,7 +68,10 @@ func (g *adjacencyMatrixGraph[T, W]) AddEdge(from, to T, weight W) error {
 	if !fromExists || !toExists {
 		return ErrVertexNotFound
 	}
-	g.matrix[fromIdx][toIdx] = &weight
+	g.setArc(fromIdx, toIdx, &weight)
+	if !g.directed {
+		g.setArc(toIdx, fromIdx, &weight)
+	}
 	return nil
 }
 
@@ -74,10 +82,33 @@ func (g *adjacencyMatrixGraph[T, W]) RemoveEdge(from, to T) error {
 	if !fromExists || !toExists {
 		return ErrVertexNotFound
 	}
-	g.matrix[fromIdx][toIdx] = nil
+	if g.matrix[fromIdx][toIdx] == nil {
+		return ErrEdgeNotFound
+	}
+	g.setArc(fromIdx, toIdx, nil)
+	if !g.directed {
+		g.setArc(toIdx, fromIdx, nil)
+	}
 	return nil
 }
 
+// setArc stores the weight of the arc between two indices, nil removing it, counting
+// negative weights.
+func (g *adjacencyMatrixGraph[T, W]) setArc(from, to int, weight *W) {
+	if old := g.matrix[from][to]; old != nil && *old < 0 {
+		g.negativeArcs--
+	}
+	if weight != nil && *weight < 0 {
+		g.negativeArcs++
+	}
+	g.matrix[from][to] = weight
+}
+
+// hasNegativeWeight checks if an edge has a negative weight.
+func (g *adjacencyMatrixGraph[T, W]) hasNegativeWeight() bool {
+	return g.negativeArcs > 0
+}
+
 // HasEdge checks if there is an edge between two vertices.
 func (g *adjacencyMatrixGraph[T, W]) HasEdge(from, to T) bool {
 	fromIdx, fromExists := g.index[from]
@@ -123,10 +154,14 @@ func (g *adjacencyMatrixGraph[T, W]) Vertices() []T {
 }
 
 // Edges returns all edges in the graph with their weights.
+// In an undirected graph every edge is reported once.
 func (g *adjacencyMatrixGraph[T, W]) Edges() []Edge[T, W] {
 	var edges []Edge[T, W]
 	for i, from := range g.vertices {
 		for j, to := range g.vertices {
+			if !g.directed && j < i {
+				continue // Already reported from the other endpoint.
+			}
 			if g.matrix[i][j] != nil {
 				edges = append(edges, Edge[T, W]{From: from, To: to, Weight: *g.matrix[i][j]})
 			}
@@ -136,6 +171,7 @@ func (g *adjacencyMatrixGraph[T, W]) Edges() []Edge[T, W] {
 }
 
 // Degree returns the out-degree of a vertex.
+// In an undirected graph it is the number of incident edges, a self-loop counts once.
 func (g *adjacencyMatrixGraph[T, W]) Degree(vertex T) (int, error) {
 	idx, exists := g.index[vertex]
 	if !exists {
@@ -151,11 +187,15 @@ func (g *adjacencyMatrixGraph[T, W]) Degree(vertex T) (int, error) {
 }
 
 // InDegree returns the in-degree of a vertex.
+// In an undirected graph it is the same as the degree.
 func (g *adjacencyMatrixGraph[T, W]) InDegree(vertex T) (int, error) {
 	idx, exists := g.index[vertex]
 	if !exists {
 		return 0, ErrVertexNotFound
 	}
+	if !g.directed {
+		return g.Degree(vertex)
+	}
 	inDegree := 0
 	for i := range g.vertices {
 		if g.matrix[i][idx] != nil {
@@ -166,6 +206,7 @@ func (g *adjacencyMatrixGraph[T, W]) InDegree(vertex T) (int, error) {
 }
 
 // Transpose returns the transposed graph (reverses all edges).
+// The transpose of an undirected graph is a copy of it.
 func (g *adjacencyMatrixGraph[T, W]) Transpose() Graph[T, W] {
 	transposed := NewAdjacencyMatrixGraph[T, W](g.directed)
 	for _, vertex := range g.vertices {
@@ -186,79 +227,47 @@ func (g *adjacencyMatrixGraph[T, W]) IsDirected() bool {
 	return g.directed
 }
 
-// ShortestPath implements Dijkstra's algorithm to find the shortest path.
-func (g *adjacencyMatrixGraph[T, W]) ShortestPath(from, to T) ([]T, error) {
-	_, fromExists := g.index[from]
-	_, toExists := g.index[to]
-	if !fromExists || !toExists {
-		return nil, ErrVertexNotFound
-	}
-
-	// Initialize distances, previous vertices, and the priority queue.
-	distances := make(map[T]W)
-	previous := make(map[T]*T)
-	pq := NewPriorityQueue[T](func(a, b T) int {
-		// Compare distances of a and b for priority queue ordering.
-		if distances[a] < distances[b] {
-			return -1
-		}
-		if distances[a] > distances[b] {
-			return 1
-		}
-		return 0
-	})
-
-	infinity := NumericMaxValue[W]()
-	zero := NumericZeroValue[W]()
-
-	// Set initial distances to infinity.
-	for _, vertex := range g.vertices {
-		distances[vertex] = infinity
-	}
-	distances[from] = zero
-
-	// Start with the source vertex in the priority queue.
-	pq.Push(from)
-
-	// Dijkstra's algorithm main loop.
-	for pq.Size() > 0 {
-		current, _ := pq.Pop()
-		currentIdx := g.index[current]
-
-		// Early exit if we reached the target vertex.
-		if current == to {
-			break
-		}
+// ShortestPath implements Dijkstra's algorithm to find the shortest path and its cost.
+func (g *adjacencyMatrixGraph[T, W]) ShortestPath(from, to T) ([]T, W, error) {
+	return shortestPath[T, W](g, from, to)
+}
 
-		// Iterate over neighbors of the current vertex.
-		for neighborIdx, weightPtr := range g.matrix[currentIdx] {
-			if weightPtr == nil {
-				continue // Skip if there is no edge.
-			}
+// ShortestPathTree implements Dijkstra's algorithm to find the shortest paths from a vertex
+// to every vertex, so they can be queried many times.
+func (g *adjacencyMatrixGraph[T, W]) ShortestPathTree(from T) (*ShortestPathTree[T, W], error) {
+	return shortestPathTree[T, W](g, from, nil)
+}
 
-			neighbor := g.vertices[neighborIdx]
-			weight := *weightPtr
-			newDist := distances[current] + weight
+// MarshalJSON encodes the graph with its directedness and weight type, listing the
+// vertices in the order they were added.
+func (g *adjacencyMatrixGraph[T, W]) MarshalJSON() ([]byte, error) {
+	return marshalGraphJSON[T, W](g, g.vertices)
+}
 
-			// Update the distance if a shorter path is found.
-			if newDist < distances[neighbor] {
-				distances[neighbor] = newDist
-				previous[neighbor] = &current
-				pq.Push(neighbor)
-			}
-		}
+// UnmarshalJSON replaces the graph with one encoded by MarshalJSON, taking its directedness.
+// If the data has another schema version or weight type, retrieve an ErrGraphSchemaVersion
+// or an ErrGraphWeightType; if it is malformed, an ErrInvalidGraphData.
+func (g *adjacencyMatrixGraph[T, W]) UnmarshalJSON(data []byte) error {
+	decoded, err := unmarshalGraphJSON(data, NewAdjacencyMatrixGraph[T, W])
+	if err != nil {
+		return err
 	}
+	*g = *decoded.(*adjacencyMatrixGraph[T, W])
+	return nil
+}
 
-	// If the destination vertex distance is still infinity, no path exists.
-	if distances[to] == infinity {
-		return nil, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
-	}
+// MarshalBinary encodes the graph like MarshalJSON in the compact format of encoding/gob.
+func (g *adjacencyMatrixGraph[T, W]) MarshalBinary() ([]byte, error) {
+	return marshalGraphBinary[T, W](g, g.vertices)
+}
 
-	// Reconstruct the path from `to` to `from` using the `previous` map.
-	var path []T
-	for at := &to; at != nil; at = previous[*at] {
-		path = append([]T{*at}, path...)
+// UnmarshalBinary replaces the graph with one encoded by MarshalBinary, taking its
+// directedness. It fails like UnmarshalJSON.
+func (g *adjacencyMatrixGraph[T, W]) UnmarshalBinary(data []byte) error {
+	decoded, err := unmarshalGraphBinary(data, NewAdjacencyMatrixGraph[T, W])
+	if err != nil {
+		return err
 	}
-
-	return path, nil
+	*g = *decoded.(*adjacencyMatrixGraph[T, W])
+	return nil
 }
@@ -87,7 +87,7 @@ func TestAdjacencyMatrixGraph_RemoveEdge(t *testing.T) {
 }
 
 func TestAdjacencyMatrixGraph_HasEdge(t *testing.T) {
-	graph := structures.NewAdjacencyMatrixGraph[string, int](false)
+	graph := structures.NewAdjacencyMatrixGraph[string, int](true)
 	_ = graph.AddVertex("A")
 	_ = graph.AddVertex("B")
 	_ = graph.AddEdge("A", "B", 5)
@@ -219,10 +219,13 @@ func TestAdjacencyMatrixGraph_ShortestPath(t *testing.T) {
 	_ = graph.AddEdge("A", "B", 5)
 	_ = graph.AddEdge("B", "C", 10)
 
-	path, err := graph.ShortestPath("A", "C")
+	path, cost, err := graph.ShortestPath("A", "C")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	if cost != 15 {
+		t.Fatalf("expected cost 15, got %d", cost)
+	}
 
 	expectedPath := []string{"A", "B", "C"}
 	if len(path) != len(expectedPath) {
@@ -234,3 +237,33 @@ func TestAdjacencyMatrixGraph_ShortestPath(t *testing.T) {
 		}
 	}
 }
+
+// TestAdjacencyMatrixGraph_ShortestPath_EqualDistances covers vertices reaching the same
+// distance, which the previous priority queue merged into a single element
+func TestAdjacencyMatrixGraph_ShortestPath_EqualDistances(t *testing.T) {
+	graph := structures.NewAdjacencyMatrixGraph[int, int](true)
+	for v := 0; v < 5; v++ {
+		_ = graph.AddVertex(v)
+	}
+	_ = graph.AddEdge(0, 4, 7)
+	_ = graph.AddEdge(0, 1, 7)
+	_ = graph.AddEdge(1, 2, 5)
+	_ = graph.AddEdge(1, 3, 4)
+	_ = graph.AddEdge(2, 1, 2)
+	_ = graph.AddEdge(2, 4, 5)
+	_ = graph.AddEdge(4, 3, 9)
+
+	path, _, err := graph.ShortestPath(0, 4)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(path) != 2 || path[0] != 0 || path[1] != 4 {
+		t.Fatalf("expected [0 4], got %v", path)
+	}
+}
+
+func TestAdjacencyMatrixGraph_ShortestPath_RandomGraphs(t *testing.T) {
+	checkShortestPathsAgainstReference(t, func() structures.Graph[int, int] {
+		return structures.NewAdjacencyMatrixGraph[int, int](true)
+	})
+}
@@ -0,0 +1,194 @@
+package structures
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DistanceMatrix holds the shortest path between every pair of vertices of a graph
+type DistanceMatrix[T comparable, W Numeric] struct {
+	vertices  []T
+	index     map[T]int
+	distances [][]W   // NumericMaxValue when there is no path
+	previous  [][]int // index of the predecessor on the path, -1 when there is none
+}
+
+// newDistanceMatrix creates a distance matrix where every vertex only reaches itself
+func newDistanceMatrix[T comparable, W Numeric](vertices []T) *DistanceMatrix[T, W] {
+	infinity := NumericMaxValue[W]()
+	m := &DistanceMatrix[T, W]{
+		vertices:  slices.Clone(vertices),
+		index:     make(map[T]int, len(vertices)),
+		distances: make([][]W, len(vertices)),
+		previous:  make([][]int, len(vertices)),
+	}
+
+	for i, vertex := range vertices {
+		m.index[vertex] = i
+		m.distances[i] = make([]W, len(vertices))
+		m.previous[i] = make([]int, len(vertices))
+		for j := range vertices {
+			if i != j {
+				m.distances[i][j] = infinity
+			}
+			m.previous[i][j] = -1
+		}
+	}
+
+	return m
+}
+
+// Vertices returns the vertices of the matrix
+func (m *DistanceMatrix[T, W]) Vertices() []T {
+	return slices.Clone(m.vertices)
+}
+
+// Distance returns the cost of the shortest path between the vertices.
+// If there is no path, retrieve NumericMaxValue and an ErrFindingShortestPath
+func (m *DistanceMatrix[T, W]) Distance(from, to T) (W, error) {
+	i, j, err := m.indexes(from, to)
+	if err != nil {
+		return NumericMaxValue[W](), err
+	}
+
+	if m.distances[i][j] == NumericMaxValue[W]() {
+		return NumericMaxValue[W](), fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
+	}
+
+	return m.distances[i][j], nil
+}
+
+// Path returns the vertices of the shortest path between the vertices
+// If there is no path, retrieve an ErrFindingShortestPath
+func (m *DistanceMatrix[T, W]) Path(from, to T) ([]T, error) {
+	i, j, err := m.indexes(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.distances[i][j] == NumericMaxValue[W]() {
+		return nil, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
+	}
+
+	path := []T{to}
+	for at := j; at != i; {
+		at = m.previous[i][at]
+		path = append(path, m.vertices[at])
+	}
+	slices.Reverse(path)
+
+	return path, nil
+}
+
+// indexes returns the positions of both vertices in the matrix
+func (m *DistanceMatrix[T, W]) indexes(from, to T) (int, int, error) {
+	i, fromExists := m.index[from]
+	j, toExists := m.index[to]
+	if !fromExists || !toExists {
+		return 0, 0, ErrVertexNotFound
+	}
+	return i, j, nil
+}
+
+// FloydWarshall finds the shortest paths between every pair of vertices in O(V³),
+// allowing negative weights. It suits dense graphs such as adjacency matrices.
+// If there is a negative cycle, retrieve a *NegativeCycleError
+func FloydWarshall[T comparable, W Numeric](g Graph[T, W]) (*DistanceMatrix[T, W], error) {
+	m := newDistanceMatrix[T, W](g.Vertices())
+	infinity := NumericMaxValue[W]()
+
+	setEdge := func(i, j int, weight W) {
+		// a self-loop only matters when it is a negative cycle
+		if weight < m.distances[i][j] {
+			m.distances[i][j] = weight
+			m.previous[i][j] = i
+		}
+	}
+
+	if matrixGraph, ok := g.(*adjacencyMatrixGraph[T, W]); ok {
+		for i, row := range matrixGraph.matrix {
+			for j, weight := range row {
+				if weight != nil {
+					setEdge(i, j, *weight)
+				}
+			}
+		}
+	} else {
+		for i, vertex := range m.vertices {
+			neighbors, _ := g.Neighbors(vertex)
+			for neighbor, weight := range neighbors {
+				setEdge(i, m.index[neighbor], weight)
+			}
+		}
+	}
+
+	n := len(m.vertices)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if m.distances[i][k] == infinity {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if m.distances[k][j] == infinity {
+					continue
+				}
+				if alt := m.distances[i][k] + m.distances[k][j]; alt < m.distances[i][j] {
+					m.distances[i][j] = alt
+					m.previous[i][j] = m.previous[k][j]
+				}
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if m.distances[i][i] < 0 {
+			// the vertex is on a negative cycle, which Bellman-Ford reports from it
+			_, err := BellmanFord(g, m.vertices[i])
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Johnson finds the shortest paths between every pair of vertices running Dijkstra's
+// algorithm from each of them, in O(V·E·log V). Negative weights are allowed, since
+// they are first reweighted with Bellman-Ford. It suits sparse graphs such as adjacency lists.
+// If there is a negative cycle, retrieve a *NegativeCycleError
+func Johnson[T comparable, W Numeric](g Graph[T, W]) (*DistanceMatrix[T, W], error) {
+	m := newDistanceMatrix[T, W](g.Vertices())
+	zero := NumericZeroValue[W]()
+
+	// potentials are the distances from a virtual vertex joined to every vertex with a
+	// zero weight edge, so w(u, v) + h(u) - h(v) is never negative
+	edges := directedEdges(g)
+	potentials := make(map[T]W, len(m.vertices))
+	for _, vertex := range m.vertices {
+		potentials[vertex] = zero
+	}
+
+	if slices.ContainsFunc(edges, func(edge Edge[T, W]) bool { return edge.Weight < zero }) {
+		previous := make(map[T]T)
+		if vertex, found := relaxEdges(edges, potentials, previous, len(m.vertices)); found {
+			return nil, &NegativeCycleError[T]{Cycle: negativeCycle(previous, vertex, len(m.vertices))}
+		}
+	}
+
+	reweight := func(from, to T, weight W) W {
+		// rounding may leave float weights slightly below zero
+		return max(weight+potentials[from]-potentials[to], zero)
+	}
+
+	for i, source := range m.vertices {
+		tree := dijkstra(g, source, reweight, nil)
+		for vertex, distance := range tree.Distances {
+			j := m.index[vertex]
+			m.distances[i][j] = distance - potentials[source] + potentials[vertex]
+			if previous, ok := tree.Previous[vertex]; ok {
+				m.previous[i][j] = m.index[previous]
+			}
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,177 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// allPairsAlgorithms lists both all-pairs shortest paths algorithms
+var allPairsAlgorithms = map[string]func(structures.Graph[string, int]) (*structures.DistanceMatrix[string, int], error){
+	"FloydWarshall": structures.FloydWarshall[string, int],
+	"Johnson":       structures.Johnson[string, int],
+}
+
+func TestAllPairs(t *testing.T) {
+	for name, algorithm := range allPairsAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A", "B", "C", "D", "E")
+				g.AddEdge("A", "B", 4)
+				g.AddEdge("A", "C", 2)
+				g.AddEdge("C", "B", -1)
+				g.AddEdge("B", "D", 3)
+				g.AddEdge("D", "A", 1)
+
+				m, err := algorithm(g)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				if distance, _ := m.Distance("A", "D"); distance != 4 {
+					t.Fatalf("expected distance 4 from A to D, got %d", distance)
+				}
+				if distance, _ := m.Distance("D", "B"); distance != 2 {
+					t.Fatalf("expected distance 2 from D to B, got %d", distance)
+				}
+				if distance, _ := m.Distance("B", "B"); distance != 0 {
+					t.Fatalf("expected distance 0 from B to B, got %d", distance)
+				}
+
+				path, err := m.Path("D", "B")
+				if err != nil || strings.Join(path, " ") != "D A C B" {
+					t.Fatalf("expected path [D A C B], got %v (error: %v)", path, err)
+				}
+				if path, _ := m.Path("C", "C"); len(path) != 1 || path[0] != "C" {
+					t.Fatalf("expected path [C], got %v", path)
+				}
+			})
+		})
+	}
+}
+
+func TestAllPairs_Unreachable(t *testing.T) {
+	for name, algorithm := range allPairsAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A", "B")
+				g.AddEdge("A", "B", 1)
+
+				m, _ := algorithm(g)
+
+				distance, err := m.Distance("B", "A")
+				if !errors.Is(err, structures.ErrFindingShortestPath) || distance != structures.NumericMaxValue[int]() {
+					t.Fatalf("expected NumericMaxValue and ErrFindingShortestPath, got %d and %v", distance, err)
+				}
+				if _, err := m.Path("B", "A"); !errors.Is(err, structures.ErrFindingShortestPath) {
+					t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+				}
+				if _, err := m.Distance("A", "Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+					t.Fatalf("expected ErrVertexNotFound, got %v", err)
+				}
+			})
+		})
+	}
+}
+
+func TestAllPairs_NegativeCycle(t *testing.T) {
+	for name, algorithm := range allPairsAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			for _, directed := range []bool{true, false} {
+				forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+					addVertices(t, g, "A", "B", "C")
+					g.AddEdge("A", "B", 1)
+					g.AddEdge("B", "C", -3)
+					g.AddEdge("C", "A", 1)
+
+					_, err := algorithm(g)
+					checkNegativeCycle(t, g, err)
+				})
+			}
+		})
+	}
+}
+
+func TestAllPairs_MatchBellmanFord(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 30; round++ {
+		for _, constructor := range graphConstructors {
+			g := constructor.newGraph(true)
+			vertices := make([]string, 9)
+			for i := range vertices {
+				vertices[i] = string(rune('a' + i))
+				g.AddVertex(vertices[i])
+			}
+			// edges only go forward, so negative weights never close a cycle
+			for i := 0; i < 25; i++ {
+				from, to := rnd.Intn(9), rnd.Intn(9)
+				if from == to {
+					continue
+				}
+				if from > to {
+					from, to = to, from
+				}
+				g.AddEdge(vertices[from], vertices[to], rnd.Intn(15)-5)
+			}
+
+			floyd, err := structures.FloydWarshall(g)
+			if err != nil {
+				t.Fatalf("FloydWarshall: expected no error, got %v", err)
+			}
+			johnson, err := structures.Johnson(g)
+			if err != nil {
+				t.Fatalf("Johnson: expected no error, got %v", err)
+			}
+
+			for _, from := range vertices {
+				tree, _ := structures.BellmanFord(g, from)
+				for _, to := range vertices {
+					expected, reachable := tree.Distances[to]
+					for name, m := range map[string]*structures.DistanceMatrix[string, int]{"FloydWarshall": floyd, "Johnson": johnson} {
+						distance, err := m.Distance(from, to)
+						if reachable != (err == nil) || (reachable && distance != expected) {
+							t.Fatalf("%s %s: expected distance %d from %v to %v (reachable %v), got %d (error: %v)",
+								constructor.name, name, expected, from, to, reachable, distance, err)
+						}
+						if !reachable {
+							continue
+						}
+
+						path, _ := m.Path(from, to)
+						cost := 0
+						for i := 0; i < len(path)-1; i++ {
+							weight, _ := g.Weight(path[i], path[i+1])
+							cost += weight
+						}
+						if path[0] != from || path[len(path)-1] != to || cost != expected {
+							t.Fatalf("%s %s: expected path from %v to %v costing %d, got %v costing %d",
+								constructor.name, name, from, to, expected, path, cost)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestJohnson_Float(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, float64](true)
+	for _, vertex := range []string{"A", "B", "C"} {
+		g.AddVertex(vertex)
+	}
+	g.AddEdge("A", "B", 0.1)
+	g.AddEdge("B", "C", -0.3)
+	g.AddEdge("A", "C", 0.2)
+
+	m, err := structures.Johnson(g)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if distance, _ := m.Distance("A", "C"); distance > -0.19999 || distance < -0.20001 {
+		t.Fatalf("expected distance -0.2, got %v", distance)
+	}
+}
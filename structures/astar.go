@@ -0,0 +1,123 @@
+package structures
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TieBreaking chooses which vertex A* expands first among those with the same estimated total cost
+type TieBreaking int
+
+const (
+	// TieBreakHighestCost prefers the vertex farthest from the start, so the closest to the
+	// target by the heuristic, which usually expands the fewest vertices
+	TieBreakHighestCost TieBreaking = iota
+	// TieBreakLowestCost prefers the vertex closest to the start
+	TieBreakLowestCost
+	// TieBreakInsertionOrder prefers the vertex that was reached first
+	TieBreakInsertionOrder
+)
+
+// AStarOption configures an A* search
+type AStarOption func(*aStarConfig)
+
+// aStarConfig stores the policies used by the A* search
+type aStarConfig struct {
+	tieBreaking TieBreaking
+}
+
+// WithTieBreaking sets how A* breaks ties between vertices with the same estimated
+// total cost. The default is TieBreakHighestCost
+func WithTieBreaking(tieBreaking TieBreaking) AStarOption {
+	return func(c *aStarConfig) {
+		c.tieBreaking = tieBreaking
+	}
+}
+
+// aStarPriority is the priority of a vertex queued by A*
+type aStarPriority[W Numeric] struct {
+	estimate W     // cost from the start plus the heuristic
+	cost     W     // cost from the start
+	order    int64 // when the vertex was queued, last resort of every tie
+}
+
+// AStar finds the shortest path between two vertices and its cost, expanding first the
+// vertices whose cost from the start plus heuristic is lowest. heuristic estimates the
+// cost from a vertex to the target.
+// The path is the shortest as long as the heuristic is admissible, never overestimating
+// the real cost. If it is also consistent, h(u) <= w(u, v) + h(v) for every edge, every
+// vertex is expanded once; otherwise vertices may be expanded again when a cheaper path
+// to them is found. A heuristic that always returns zero makes it Dijkstra's algorithm.
+// Weights must not be negative, an ErrNegativeWeight is retrieved when an expanded
+// vertex has a negative edge
+func AStar[T comparable, W Numeric](g Graph[T, W], from, to T, heuristic func(vertex T) W, opts ...AStarOption) ([]T, W, error) {
+	var config aStarConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if _, err := g.Neighbors(from); err != nil {
+		return nil, 0, err
+	}
+	if _, err := g.Neighbors(to); err != nil {
+		return nil, 0, err
+	}
+
+	pq := NewIndexedPriorityQueue[T, aStarPriority[W]](func(a, b aStarPriority[W]) bool {
+		if a.estimate != b.estimate {
+			return a.estimate < b.estimate
+		}
+		if a.cost != b.cost {
+			switch config.tieBreaking {
+			case TieBreakHighestCost:
+				return a.cost > b.cost
+			case TieBreakLowestCost:
+				return a.cost < b.cost
+			}
+		}
+		return a.order < b.order
+	})
+
+	zero := NumericZeroValue[W]()
+	costs := map[T]W{from: zero}
+	previous := make(map[T]T)
+	var order int64
+
+	pq.Push(from, aStarPriority[W]{estimate: heuristic(from), cost: zero})
+
+	for pq.Size() > 0 {
+		current, priority, _ := pq.PopMin()
+		if current == to {
+			path := []T{to}
+			for at, ok := previous[to]; ok; at, ok = previous[at] {
+				path = append(path, at)
+			}
+			slices.Reverse(path)
+			return path, priority.cost, nil
+		}
+
+		neighbors, _ := g.Neighbors(current)
+		for neighbor, weight := range neighbors {
+			if weight < zero {
+				return nil, 0, fmt.Errorf("%w: %v -> %v, use BellmanFord", ErrNegativeWeight, current, neighbor)
+			}
+
+			alt := priority.cost + weight
+			if known, visited := costs[neighbor]; visited && alt >= known {
+				continue
+			}
+
+			costs[neighbor] = alt
+			previous[neighbor] = current
+			order++
+			next := aStarPriority[W]{estimate: alt + heuristic(neighbor), cost: alt, order: order}
+			if pq.Contains(neighbor) {
+				pq.Update(neighbor, next)
+			} else {
+				pq.Push(neighbor, next)
+			}
+		}
+	}
+
+	return nil, 0, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
+}
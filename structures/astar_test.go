@@ -0,0 +1,231 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// gridCell is a vertex of a grid graph
+type gridCell struct {
+	row, col int
+}
+
+// gridGraph builds a directed graph joining the adjacent open cells of the grid, where
+// '#' is a wall and a digit is the cost of entering the cell. Any other character is
+// an open cell of cost 1
+func gridGraph(newGraph func(directed bool) structures.Graph[gridCell, int], grid []string) structures.Graph[gridCell, int] {
+	g := newGraph(true)
+	cost := func(cell gridCell) int {
+		if c := grid[cell.row][cell.col]; c >= '1' && c <= '9' {
+			return int(c - '0')
+		}
+		return 1
+	}
+
+	for row := range grid {
+		for col := range grid[row] {
+			if grid[row][col] != '#' {
+				g.AddVertex(gridCell{row, col})
+			}
+		}
+	}
+
+	for _, cell := range g.Vertices() {
+		for _, step := range []gridCell{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			next := gridCell{cell.row + step.row, cell.col + step.col}
+			if next.row < 0 || next.row >= len(grid) || next.col < 0 || next.col >= len(grid[next.row]) {
+				continue
+			}
+			if grid[next.row][next.col] != '#' {
+				g.AddEdge(cell, next, cost(next))
+			}
+		}
+	}
+
+	return g
+}
+
+// manhattan returns an admissible heuristic for grids whose cells cost at least 1
+func manhattan(target gridCell) func(cell gridCell) int {
+	return func(cell gridCell) int {
+		return abs(cell.row-target.row) + abs(cell.col-target.col)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// countingGraph counts the vertices whose neighbors were requested
+type countingGraph struct {
+	structures.Graph[gridCell, int]
+	expanded int
+}
+
+func (g *countingGraph) Neighbors(vertex gridCell) (map[gridCell]int, error) {
+	g.expanded++
+	return g.Graph.Neighbors(vertex)
+}
+
+// gridConstructors lists every Graph implementation used for grids
+var gridConstructors = map[string]func(directed bool) structures.Graph[gridCell, int]{
+	"AdjacencyListGraph":   structures.NewAdjacencyListGraph[gridCell, int],
+	"AdjacencyMatrixGraph": structures.NewAdjacencyMatrixGraph[gridCell, int],
+}
+
+// pathCost adds up the weights of the path
+func pathCost(t *testing.T, g structures.Graph[gridCell, int], path []gridCell) int {
+	t.Helper()
+	cost := 0
+	for i := 0; i < len(path)-1; i++ {
+		weight, err := g.Weight(path[i], path[i+1])
+		if err != nil {
+			t.Fatalf("expected edge %v -> %v in path %v", path[i], path[i+1], path)
+		}
+		cost += weight
+	}
+	return cost
+}
+
+func TestAStar_Grid(t *testing.T) {
+	grid := []string{
+		"..........",
+		"...#......",
+		".#......#.",
+		"...#......",
+		"...#......",
+		"...#......",
+		"..99......",
+	}
+	from, to := gridCell{0, 0}, gridCell{6, 0}
+
+	for name, newGraph := range gridConstructors {
+		t.Run(name, func(t *testing.T) {
+			g := gridGraph(newGraph, grid)
+
+			path, cost, err := structures.AStar(g, from, to, manhattan(to))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			_, expectedCost, _ := g.ShortestPath(from, to)
+			if cost != expectedCost {
+				t.Fatalf("expected cost %d, got %d", expectedCost, cost)
+			}
+			if pathCost(t, g, path) != cost || path[0] != from || path[len(path)-1] != to {
+				t.Fatalf("expected a path from %v to %v costing %d, got %v", from, to, cost, path)
+			}
+		})
+	}
+}
+
+func TestAStar_RandomGrids(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 30; round++ {
+		grid := make([]string, 8)
+		for row := range grid {
+			cells := make([]byte, 8)
+			for col := range cells {
+				switch n := rnd.Intn(10); {
+				case n < 2:
+					cells[col] = '#'
+				case n < 4:
+					cells[col] = byte('1' + rnd.Intn(9))
+				default:
+					cells[col] = '.'
+				}
+			}
+			grid[row] = string(cells)
+		}
+		from, to := gridCell{0, 0}, gridCell{7, 7}
+		grid[0] = "." + grid[0][1:]
+		grid[7] = grid[7][:7] + "."
+
+		g := gridGraph(structures.NewAdjacencyListGraph[gridCell, int], grid)
+		for _, tieBreaking := range []structures.TieBreaking{structures.TieBreakHighestCost, structures.TieBreakLowestCost, structures.TieBreakInsertionOrder} {
+			path, cost, err := structures.AStar(g, from, to, manhattan(to), structures.WithTieBreaking(tieBreaking))
+
+			_, expectedCost, expectedErr := g.ShortestPath(from, to)
+			if expectedErr != nil {
+				if !errors.Is(err, structures.ErrFindingShortestPath) {
+					t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+				}
+				continue
+			}
+
+			if err != nil || cost != expectedCost || cost != pathCost(t, g, path) {
+				t.Fatalf("grid %v: expected cost %d, got %v costing %d (error: %v)", grid, expectedCost, path, cost, err)
+			}
+		}
+	}
+}
+
+func TestAStar_ExpandsLessThanDijkstra(t *testing.T) {
+	grid := make([]string, 30)
+	for row := range grid {
+		grid[row] = ".............................."
+	}
+	from, to := gridCell{15, 0}, gridCell{15, 29}
+
+	g := &countingGraph{Graph: gridGraph(structures.NewAdjacencyListGraph[gridCell, int], grid)}
+	zero := func(gridCell) int { return 0 }
+
+	_, dijkstraCost, _ := structures.AStar[gridCell, int](g, from, to, zero)
+	dijkstraExpanded := g.expanded
+
+	g.expanded = 0
+	_, cost, _ := structures.AStar[gridCell, int](g, from, to, manhattan(to))
+
+	if cost != dijkstraCost || cost != 29 {
+		t.Fatalf("expected cost 29, got %d and %d", cost, dijkstraCost)
+	}
+	if g.expanded*10 > dijkstraExpanded {
+		t.Fatalf("expected A* to expand far less than %d vertices, got %d", dijkstraExpanded, g.expanded)
+	}
+}
+
+func TestAStar_InconsistentHeuristic(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](true)
+	for _, vertex := range []string{"S", "A", "B", "C", "T"} {
+		g.AddVertex(vertex)
+	}
+	g.AddEdge("S", "A", 1)
+	g.AddEdge("S", "B", 2)
+	g.AddEdge("A", "C", 3)
+	g.AddEdge("B", "C", 1)
+	g.AddEdge("C", "T", 3)
+
+	// admissible but not consistent: B looks far, so C is first expanded through A
+	heuristic := map[string]int{"S": 6, "A": 5, "B": 4, "C": 1, "T": 0}
+	path, cost, err := structures.AStar[string, int](g, "S", "T", func(v string) int { return heuristic[v] },
+		structures.WithTieBreaking(structures.TieBreakLowestCost))
+
+	if err != nil || cost != 6 || len(path) != 4 || path[1] != "B" {
+		t.Fatalf("expected path [S B C T] costing 6, got %v costing %d (error: %v)", path, cost, err)
+	}
+}
+
+func TestAStar_Errors(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](true)
+	for _, vertex := range []string{"A", "B", "C"} {
+		g.AddVertex(vertex)
+	}
+	g.AddEdge("A", "B", -1)
+	zero := func(string) int { return 0 }
+
+	if _, _, err := structures.AStar[string, int](g, "A", "Z", zero); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+	if _, _, err := structures.AStar[string, int](g, "A", "B", zero); !errors.Is(err, structures.ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight, got %v", err)
+	}
+	if _, _, err := structures.AStar[string, int](g, "C", "A", zero); !errors.Is(err, structures.ErrFindingShortestPath) {
+		t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+	}
+}
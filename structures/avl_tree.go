@@ -0,0 +1,218 @@
+package structures
+
+// avlTree represents a self-balancing binary search tree where the heights of the
+// two subtrees of every node differ at most by one
+type avlTree[T comparable] struct {
+	root    *SearchBinaryTreeNode[T]
+	compare func(a, b T) int
+	size    int64
+}
+
+// NewAVLTree creates a new AVL tree, a binary search tree that keeps itself balanced
+// so Push, Find and Delete are O(log n) even when values are pushed in order
+func NewAVLTree[T comparable](compare func(a, b T) int) SearchBinaryTreer[T] {
+	return &avlTree[T]{compare: compare}
+}
+
+// Push inserts a new value into the tree, duplicated values are ignored
+func (tree *avlTree[T]) Push(value T) {
+	var path []*SearchBinaryTreeNode[T]
+
+	current := tree.root
+	for current != nil {
+		cmp := tree.compare(value, current.value)
+		if cmp == 0 {
+			return
+		}
+
+		path = append(path, current)
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	newNode := &SearchBinaryTreeNode[T]{value: value, height: 1}
+	tree.size++
+
+	if len(path) == 0 {
+		tree.root = newNode
+		return
+	}
+
+	parent := path[len(path)-1]
+	if tree.compare(value, parent.value) < 0 {
+		parent.left = newNode
+	} else {
+		parent.right = newNode
+	}
+
+	tree.rebalancePath(path)
+}
+
+// Find finds a value in the tree
+func (tree *avlTree[T]) Find(value T) bool {
+	current := tree.root
+	for current != nil {
+		cmp := tree.compare(value, current.value)
+		if cmp == 0 {
+			return true
+		}
+
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	return false
+}
+
+// Delete deletes a value from the tree
+// If the value does not exist, retrieve an ErrorSearchBinaryTreeValueNotFound
+func (tree *avlTree[T]) Delete(value T) error {
+	var path []*SearchBinaryTreeNode[T]
+
+	current := tree.root
+	for current != nil {
+		cmp := tree.compare(value, current.value)
+		if cmp == 0 {
+			break
+		}
+
+		path = append(path, current)
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	if current == nil {
+		return ErrorSearchBinaryTreeValueNotFound
+	}
+
+	// Node with two children: replace its value with the inorder successor
+	// (smallest in the right subtree) and remove the successor instead
+	if current.left != nil && current.right != nil {
+		path = append(path, current)
+		successor := current.right
+		for successor.left != nil {
+			path = append(path, successor)
+			successor = successor.left
+		}
+		current.value = successor.value
+		current = successor
+	}
+
+	// Now the node to remove has one child or no child
+	child := current.left
+	if child == nil {
+		child = current.right
+	}
+
+	if len(path) == 0 {
+		tree.root = child
+	} else {
+		parent := path[len(path)-1]
+		if parent.left == current {
+			parent.left = child
+		} else {
+			parent.right = child
+		}
+	}
+
+	tree.size--
+	tree.rebalancePath(path)
+
+	return nil
+}
+
+// rebalancePath updates the heights and rotates the nodes of the path from the
+// deepest one up to the root
+func (tree *avlTree[T]) rebalancePath(path []*SearchBinaryTreeNode[T]) {
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i]
+		balanced := balanceAVLNode(node)
+
+		if i == 0 {
+			tree.root = balanced
+			continue
+		}
+
+		parent := path[i-1]
+		if parent.left == node {
+			parent.left = balanced
+		} else {
+			parent.right = balanced
+		}
+	}
+}
+
+// Size returns the size of the tree
+func (tree *avlTree[T]) Size() int64 {
+	return tree.size
+}
+
+// Root returns the root node of the tree
+func (tree *avlTree[T]) Root() *SearchBinaryTreeNode[T] {
+	return tree.root
+}
+
+// avlHeight returns the height of the node, zero for nil nodes
+func avlHeight[T any](node *SearchBinaryTreeNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+// updateAVLHeight recalculates the height of the node from its children
+func updateAVLHeight[T any](node *SearchBinaryTreeNode[T]) {
+	node.height = 1 + max(avlHeight(node.left), avlHeight(node.right))
+}
+
+// rotateAVLRight rotates the node to the right and returns the new subtree root
+func rotateAVLRight[T any](node *SearchBinaryTreeNode[T]) *SearchBinaryTreeNode[T] {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	updateAVLHeight(node)
+	updateAVLHeight(left)
+	return left
+}
+
+// rotateAVLLeft rotates the node to the left and returns the new subtree root
+func rotateAVLLeft[T any](node *SearchBinaryTreeNode[T]) *SearchBinaryTreeNode[T] {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	updateAVLHeight(node)
+	updateAVLHeight(right)
+	return right
+}
+
+// balanceAVLNode updates the height of the node and rotates it when its subtrees
+// heights differ by more than one, returning the new subtree root
+func balanceAVLNode[T any](node *SearchBinaryTreeNode[T]) *SearchBinaryTreeNode[T] {
+	updateAVLHeight(node)
+	balance := avlHeight(node.left) - avlHeight(node.right)
+
+	if balance > 1 {
+		if avlHeight(node.left.left) < avlHeight(node.left.right) {
+			node.left = rotateAVLLeft(node.left)
+		}
+		return rotateAVLRight(node)
+	}
+
+	if balance < -1 {
+		if avlHeight(node.right.right) < avlHeight(node.right.left) {
+			node.right = rotateAVLRight(node.right)
+		}
+		return rotateAVLLeft(node)
+	}
+
+	return node
+}
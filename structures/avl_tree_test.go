@@ -0,0 +1,226 @@
+package structures_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// checkAVLInvariants verifies the values are ordered, the subtrees heights of every
+// node differ at most by one and the tree holds size nodes
+func checkAVLInvariants(t *testing.T, tree structures.SearchBinaryTreer[int]) {
+	t.Helper()
+
+	var count int64
+	var check func(node *structures.SearchBinaryTreeNode[int], low, high *int) (int, error)
+	check = func(node *structures.SearchBinaryTreeNode[int], low, high *int) (int, error) {
+		if node == nil {
+			return 0, nil
+		}
+		count++
+
+		value := node.Value()
+		if (low != nil && value <= *low) || (high != nil && value >= *high) {
+			return 0, fmt.Errorf("value %v out of order", value)
+		}
+
+		leftHeight, err := check(node.Left(), low, &value)
+		if err != nil {
+			return 0, err
+		}
+		rightHeight, err := check(node.Right(), &value, high)
+		if err != nil {
+			return 0, err
+		}
+
+		if leftHeight-rightHeight > 1 || rightHeight-leftHeight > 1 {
+			return 0, fmt.Errorf("node %v unbalanced: left height %v, right height %v", value, leftHeight, rightHeight)
+		}
+
+		return 1 + max(leftHeight, rightHeight), nil
+	}
+
+	if _, err := check(tree.Root(), nil, nil); err != nil {
+		t.Fatalf("AVLTree invariant broken: %v", err)
+	}
+
+	if count != tree.Size() {
+		t.Fatalf("AVLTree invariant broken: Size %v, but %v nodes", tree.Size(), count)
+	}
+}
+
+func TestNewAVLTree(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+	if tree == nil {
+		t.Fatal("NewAVLTree should not return nil")
+	}
+
+	if tree.Size() != 0 || tree.Root() != nil {
+		t.Fatalf("NewAVLTree: Expected an empty tree")
+	}
+}
+
+func TestAVLTree_Push_Sorted(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+
+	for i := 0; i < 1000; i++ {
+		tree.Push(i)
+	}
+
+	checkAVLInvariants(t, tree)
+
+	if tree.Size() != 1000 {
+		t.Fatalf("AVLTree.Push: Expected 1000, got %v", tree.Size())
+	}
+
+	// a tree of 1000 nodes with AVL balance can not be taller than 1.44*log2(1000)
+	height := 0
+	for node := tree.Root(); node != nil; node = node.Left() {
+		height++
+	}
+	if height > 14 {
+		t.Fatalf("AVLTree.Push: Expected a balanced tree, got a left spine of %v", height)
+	}
+}
+
+func TestAVLTree_Push_Duplicate(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+
+	tree.Push(5)
+	tree.Push(5)
+
+	if tree.Size() != 1 {
+		t.Fatalf("AVLTree.Push: Expected 1, got %v", tree.Size())
+	}
+}
+
+func TestAVLTree_Find(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+
+	for _, num := range []int{5, 3, 7, 1, 4, 6, 8} {
+		tree.Push(num)
+	}
+
+	if !tree.Find(4) {
+		t.Fatalf("AVLTree.Find: Expected true, got false")
+	}
+
+	if tree.Find(9) {
+		t.Fatalf("AVLTree.Find: Expected false, got true")
+	}
+}
+
+func TestAVLTree_Delete(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+	nums := []int{5, 3, 7, 1, 4, 6, 8}
+
+	for _, num := range nums {
+		tree.Push(num)
+	}
+
+	for i, num := range nums {
+		if err := tree.Delete(num); err != nil {
+			t.Fatalf("AVLTree.Delete: Expected nil for %v, got %v", num, err)
+		}
+		checkAVLInvariants(t, tree)
+
+		if tree.Find(num) {
+			t.Fatalf("AVLTree.Delete: Expected %v to be deleted", num)
+		}
+		if tree.Size() != int64(len(nums)-i-1) {
+			t.Fatalf("AVLTree.Delete: Expected %v, got %v", len(nums)-i-1, tree.Size())
+		}
+	}
+}
+
+func TestAVLTree_Delete_NotFound(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+	tree.Push(1)
+
+	if err := tree.Delete(2); !errors.Is(err, structures.ErrorSearchBinaryTreeValueNotFound) {
+		t.Fatalf("AVLTree.Delete: Expected ErrorSearchBinaryTreeValueNotFound, got %v", err)
+	}
+
+	if tree.Size() != 1 {
+		t.Fatalf("AVLTree.Delete: Expected 1, got %v", tree.Size())
+	}
+}
+
+func TestAVLTree_RandomOperations(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+	expected := make(map[int]bool)
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 5000; i++ {
+		value := rnd.Intn(500)
+		if rnd.Intn(3) == 0 {
+			err := tree.Delete(value)
+			if expected[value] != (err == nil) {
+				t.Fatalf("AVLTree.Delete: unexpected result %v for %v", err, value)
+			}
+			delete(expected, value)
+		} else {
+			tree.Push(value)
+			expected[value] = true
+		}
+
+		if i%100 == 0 {
+			checkAVLInvariants(t, tree)
+		}
+	}
+
+	checkAVLInvariants(t, tree)
+
+	for value := 0; value < 500; value++ {
+		if tree.Find(value) != expected[value] {
+			t.Fatalf("AVLTree.Find: Expected %v for %v", expected[value], value)
+		}
+	}
+}
+
+func TestAVLTree_Delete_Sorted_Large(t *testing.T) {
+	tree := structures.NewAVLTree[int](compareInts)
+
+	// a plain search binary tree would recurse once per element here
+	for i := 0; i < 200000; i++ {
+		tree.Push(i)
+	}
+	for i := 0; i < 200000; i += 2 {
+		if err := tree.Delete(i); err != nil {
+			t.Fatalf("AVLTree.Delete: Expected nil, got %v", err)
+		}
+	}
+
+	checkAVLInvariants(t, tree)
+}
+
+func BenchmarkSearchBinaryTree_PushSorted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tree := structures.NewSearchBinaryTree[int](compareInts)
+		for j := 0; j < 1000; j++ {
+			tree.Push(j)
+		}
+	}
+}
+
+func BenchmarkAVLTree_PushSorted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tree := structures.NewAVLTree[int](compareInts)
+		for j := 0; j < 1000; j++ {
+			tree.Push(j)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrNegativeWeight = errors.New("negative edge weight")
+	ErrNegativeCycle  = errors.New("negative cycle detected")
+)
+
+// NegativeCycleError is the error returned when a cycle of negative total weight makes
+// shortest paths undefined, it matches ErrNegativeCycle
+type NegativeCycleError[T comparable] struct {
+	// Cycle lists the vertices of the cycle, starting and ending on the same vertex
+	Cycle []T
+}
+
+// Error describes the cycle
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Cycle)
+}
+
+// Unwrap returns ErrNegativeCycle
+func (e *NegativeCycleError[T]) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// BellmanFord finds the shortest paths from source to every vertex, allowing negative
+// weights. In an undirected graph a negative edge is a negative cycle by itself.
+// If a negative cycle is reachable from source, retrieve a *NegativeCycleError
+func BellmanFord[T comparable, W Numeric](g Graph[T, W], source T) (*ShortestPathTree[T, W], error) {
+	if _, err := g.Neighbors(source); err != nil {
+		return nil, err
+	}
+
+	tree := &ShortestPathTree[T, W]{
+		Source:    source,
+		Distances: map[T]W{source: NumericZeroValue[W]()},
+		Previous:  make(map[T]T),
+	}
+
+	// without negative cycles every shortest path has fewer edges than vertices
+	vertices := len(g.Vertices())
+	if vertex, found := relaxEdges(directedEdges(g), tree.Distances, tree.Previous, vertices-1); found {
+		return nil, &NegativeCycleError[T]{Cycle: negativeCycle(tree.Previous, vertex, vertices)}
+	}
+
+	return tree, nil
+}
+
+// directedEdges returns the edges of the graph, with both directions of every
+// undirected edge
+func directedEdges[T comparable, W Numeric](g Graph[T, W]) []Edge[T, W] {
+	edges := g.Edges()
+	if !g.IsDirected() {
+		for _, edge := range edges {
+			if edge.From != edge.To {
+				edges = append(edges, Edge[T, W]{From: edge.To, To: edge.From, Weight: edge.Weight})
+			}
+		}
+	}
+	return edges
+}
+
+// relaxEdges lowers the distances through the edges until they stop changing, for at
+// most the given rounds. A vertex still lowered in one more round is returned, since
+// it proves there is a negative cycle
+func relaxEdges[T comparable, W Numeric](edges []Edge[T, W], distances map[T]W, previous map[T]T, rounds int) (T, bool) {
+	var zero T
+
+	for round := 0; round <= rounds; round++ {
+		relaxed := false
+		var last T
+
+		for _, edge := range edges {
+			distance, reachable := distances[edge.From]
+			if !reachable {
+				continue
+			}
+
+			alt := distance + edge.Weight
+			if known, visited := distances[edge.To]; visited && alt >= known {
+				continue
+			}
+
+			distances[edge.To] = alt
+			previous[edge.To] = edge.From
+			relaxed, last = true, edge.To
+		}
+
+		if !relaxed {
+			return zero, false
+		}
+		if round == rounds {
+			return last, true
+		}
+	}
+
+	return zero, false
+}
+
+// negativeCycle walks the predecessors of a vertex relaxed after the last round, which
+// lead to a negative cycle, and returns that cycle in the direction of its edges
+func negativeCycle[T comparable](previous map[T]T, vertex T, vertices int) []T {
+	// going back once per vertex ensures the walk is inside the cycle
+	for i := 0; i < vertices; i++ {
+		vertex = previous[vertex]
+	}
+
+	cycle := []T{vertex}
+	for at := previous[vertex]; at != vertex; at = previous[at] {
+		cycle = append(cycle, at)
+	}
+	cycle = append(cycle, vertex)
+	slices.Reverse(cycle)
+
+	return cycle
+}
@@ -0,0 +1,164 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// checkNegativeCycle fails the test if err does not carry a closed cycle of negative weight
+func checkNegativeCycle(t *testing.T, g structures.Graph[string, int], err error) {
+	t.Helper()
+
+	var cycleErr *structures.NegativeCycleError[string]
+	if !errors.Is(err, structures.ErrNegativeCycle) || !errors.As(err, &cycleErr) {
+		t.Fatalf("expected a NegativeCycleError, got %v", err)
+	}
+
+	cycle := cycleErr.Cycle
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("expected a closed cycle, got %v", cycle)
+	}
+
+	total := 0
+	for i := 0; i < len(cycle)-1; i++ {
+		weight, err := g.Weight(cycle[i], cycle[i+1])
+		if err != nil {
+			t.Fatalf("expected edge %v -> %v in cycle %v", cycle[i], cycle[i+1], cycle)
+		}
+		total += weight
+	}
+	if total >= 0 {
+		t.Fatalf("expected negative total weight for cycle %v, got %d", cycle, total)
+	}
+}
+
+func TestBellmanFord_NegativeWeights(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C", "D", "E")
+		g.AddEdge("A", "B", 4)
+		g.AddEdge("A", "C", 2)
+		g.AddEdge("C", "B", -3)
+		g.AddEdge("B", "D", 2)
+		g.AddEdge("D", "C", 1)
+
+		tree, err := structures.BellmanFord(g, "A")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expected := map[string]int{"A": 0, "B": -1, "C": 2, "D": 1}
+		if len(tree.Distances) != len(expected) {
+			t.Fatalf("expected distances %v, got %v", expected, tree.Distances)
+		}
+		for vertex, distance := range expected {
+			if tree.Distances[vertex] != distance {
+				t.Fatalf("%v: expected distance %d, got %d", vertex, distance, tree.Distances[vertex])
+			}
+		}
+		if tree.Previous["B"] != "C" || tree.Previous["D"] != "B" {
+			t.Fatalf("expected predecessors C of B and B of D, got %v", tree.Previous)
+		}
+
+		path, err := tree.PathTo("D")
+		if err != nil || strings.Join(path, " ") != "A C B D" {
+			t.Fatalf("expected path [A C B D], got %v (error: %v)", path, err)
+		}
+		if _, err := tree.PathTo("E"); !errors.Is(err, structures.ErrFindingShortestPath) {
+			t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+		}
+	})
+}
+
+func TestBellmanFord_NegativeCycle(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "S", "A", "B", "C", "D")
+		g.AddEdge("S", "A", 1)
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", -2)
+		g.AddEdge("C", "A", -1)
+		g.AddEdge("C", "D", 1)
+
+		_, err := structures.BellmanFord(g, "S")
+		checkNegativeCycle(t, g, err)
+
+		if _, err := structures.BellmanFord(g, "D"); err != nil {
+			t.Fatalf("expected unreachable cycle to be ignored, got %v", err)
+		}
+	})
+}
+
+func TestBellmanFord_NegativeSelfLoop(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A")
+		g.AddEdge("A", "A", -1)
+
+		_, err := structures.BellmanFord(g, "A")
+		checkNegativeCycle(t, g, err)
+	})
+}
+
+func TestBellmanFord_Undirected(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 2)
+		g.AddEdge("B", "C", 3)
+
+		tree, err := structures.BellmanFord(g, "C")
+		if err != nil || tree.Distances["A"] != 5 {
+			t.Fatalf("expected distance 5 to A, got %v (error: %v)", tree, err)
+		}
+
+		g.AddEdge("A", "C", -1)
+		_, err = structures.BellmanFord(g, "A")
+		checkNegativeCycle(t, g, err)
+	})
+}
+
+func TestBellmanFord_MissingSource(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		if _, err := structures.BellmanFord(g, "Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("expected ErrVertexNotFound, got %v", err)
+		}
+	})
+}
+
+func TestBellmanFord_MatchesShortestPath(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		g := structures.NewAdjacencyListGraph[int, int](true)
+		for i := 0; i < 10; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < 25; i++ {
+			g.AddEdge(rnd.Intn(10), rnd.Intn(10), rnd.Intn(9)+1)
+		}
+
+		tree, err := structures.BellmanFord(g, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for to := 1; to < 10; to++ {
+			path, _, err := g.ShortestPath(0, to)
+			if _, reachable := tree.Distances[to]; !reachable {
+				if err == nil {
+					t.Fatalf("expected no path to %d, got %v", to, path)
+				}
+				continue
+			}
+
+			cost := 0
+			for i := 0; i < len(path)-1; i++ {
+				weight, _ := g.Weight(path[i], path[i+1])
+				cost += weight
+			}
+			if cost != tree.Distances[to] {
+				t.Fatalf("expected cost %d to %d, got %d", tree.Distances[to], to, cost)
+			}
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrNotBipartite         = errors.New("graph is not bipartite")
+	ErrNoCompleteAssignment = errors.New("no assignment covers the smaller side")
+)
+
+// OddCycleError is the error returned when a cycle of odd length proves a graph is not
+// bipartite, it matches ErrNotBipartite
+type OddCycleError[T comparable] struct {
+	// Cycle lists the vertices of the cycle, starting and ending on the same vertex
+	Cycle []T
+}
+
+// Error describes the cycle
+func (e *OddCycleError[T]) Error() string {
+	return fmt.Sprintf("%v: odd cycle %v", ErrNotBipartite, e.Cycle)
+}
+
+// Unwrap returns ErrNotBipartite
+func (e *OddCycleError[T]) Unwrap() error {
+	return ErrNotBipartite
+}
+
+// IsBipartite splits the vertices of an undirected graph in two sides so every edge joins
+// both sides, coloring every connected component with a breadth-first search. The first
+// vertex of every component, in the order of Vertices, goes to the left side.
+// If the graph has a cycle of odd length, retrieve an *OddCycleError; if it is directed,
+// an ErrDirectedGraph
+func IsBipartite[T comparable, W Numeric](g Graph[T, W]) ([]T, []T, error) {
+	if g.IsDirected() {
+		return nil, nil, ErrDirectedGraph
+	}
+
+	vertices := g.Vertices()
+	onLeft := make(map[T]bool, len(vertices))
+	parent := make(map[T]T, len(vertices))
+
+	for _, root := range vertices {
+		if _, colored := onLeft[root]; colored {
+			continue
+		}
+
+		onLeft[root] = true
+		queue := []T{root}
+		for len(queue) > 0 {
+			vertex := queue[0]
+			queue = queue[1:]
+
+			neighbors, _ := g.Neighbors(vertex)
+			for neighbor := range neighbors {
+				side, colored := onLeft[neighbor]
+				if !colored {
+					onLeft[neighbor] = !onLeft[vertex]
+					parent[neighbor] = vertex
+					queue = append(queue, neighbor)
+					continue
+				}
+
+				if side == onLeft[vertex] {
+					return nil, nil, &OddCycleError[T]{Cycle: oddCycle(parent, vertex, neighbor)}
+				}
+			}
+		}
+	}
+
+	var left, right []T
+	for _, vertex := range vertices {
+		if onLeft[vertex] {
+			left = append(left, vertex)
+		} else {
+			right = append(right, vertex)
+		}
+	}
+
+	return left, right, nil
+}
+
+// oddCycle closes the cycle made by an edge joining two vertices of the same side with
+// the paths of the breadth-first tree leading to them, which have the same length
+func oddCycle[T comparable](parent map[T]T, a, b T) []T {
+	var up, down []T
+	for a != b {
+		up = append(up, a)
+		down = append(down, b)
+		a, b = parent[a], parent[b]
+	}
+
+	cycle := append(up, a)
+	slices.Reverse(down)
+	cycle = append(cycle, down...)
+	return append(cycle, cycle[0])
+}
+
+// bipartiteGraph holds the edges of a graph going from the vertices of the left side to
+// the vertices of the right side, both stored by their index in the side
+type bipartiteGraph[T comparable, W Numeric] struct {
+	adjacency [][]int // right vertices joined to every left vertex, in order
+	weights   [][]W   // weight of every edge in adjacency
+}
+
+// newBipartiteGraph reads the edges of g between both sides, ignoring those reaching
+// vertices of neither side.
+// If a vertex is listed twice or an edge joins two vertices of the same side, retrieve an
+// ErrNotBipartite; if a vertex is not in the graph, an ErrVertexNotFound
+func newBipartiteGraph[T comparable, W Numeric](g Graph[T, W], left, right []T) (*bipartiteGraph[T, W], error) {
+	const (
+		leftSide = iota + 1
+		rightSide
+	)
+
+	side := make(map[T]int, len(left)+len(right))
+	index := make(map[T]int, len(left)+len(right))
+	for s, vertices := range [][]T{left, right} {
+		for i, vertex := range vertices {
+			if _, listed := side[vertex]; listed {
+				return nil, fmt.Errorf("%w: %v is listed twice", ErrNotBipartite, vertex)
+			}
+			side[vertex] = leftSide + s
+			index[vertex] = i
+		}
+	}
+
+	b := &bipartiteGraph[T, W]{
+		adjacency: make([][]int, len(left)),
+		weights:   make([][]W, len(left)),
+	}
+
+	for _, vertex := range append(slices.Clone(left), right...) {
+		neighbors, err := g.Neighbors(vertex)
+		if err != nil {
+			return nil, err
+		}
+
+		for neighbor := range neighbors {
+			if side[neighbor] == side[vertex] {
+				return nil, fmt.Errorf("%w: %v - %v joins vertices of the same side", ErrNotBipartite, vertex, neighbor)
+			}
+			if side[vertex] == leftSide && side[neighbor] == rightSide {
+				i := index[vertex]
+				b.adjacency[i] = append(b.adjacency[i], index[neighbor])
+			}
+		}
+	}
+
+	for i, adjacent := range b.adjacency {
+		slices.Sort(adjacent)
+		b.weights[i] = make([]W, len(adjacent))
+		for k, j := range adjacent {
+			b.weights[i][k], _ = g.Weight(left[i], right[j])
+		}
+	}
+
+	return b, nil
+}
+
+// HopcroftKarp finds a maximum matching between the left and the right vertices of a
+// graph, ignoring weights, in O(E·√V). It reads the edges going from left to right, so
+// in an undirected graph every edge counts. It returns the matched edges, each going
+// from left to right.
+// If a vertex is listed twice or an edge joins two vertices of the same side, retrieve an
+// ErrNotBipartite; if a vertex is not in the graph, an ErrVertexNotFound
+func HopcroftKarp[T comparable, W Numeric](g Graph[T, W], left, right []T) ([]Edge[T, W], error) {
+	b, err := newBipartiteGraph(g, left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	const unmatched = -1
+	matchLeft, _ := b.maximumMatching(len(right))
+
+	var matching []Edge[T, W]
+	for i, j := range matchLeft {
+		if j == unmatched {
+			continue
+		}
+		k, _ := slices.BinarySearch(b.adjacency[i], j)
+		matching = append(matching, Edge[T, W]{From: left[i], To: right[j], Weight: b.weights[i][k]})
+	}
+
+	return matching, nil
+}
+
+// maximumMatching runs the phases of Hopcroft-Karp, each augmenting a maximal set of
+// vertex-disjoint shortest augmenting paths. It returns the right vertex matched to every
+// left vertex, -1 if none, and the number of phases
+func (b *bipartiteGraph[T, W]) maximumMatching(rights int) ([]int, int) {
+	const unmatched = -1
+	matchLeft := make([]int, len(b.adjacency)) // right vertex matched to every left vertex
+	matchRight := make([]int, rights)          // left vertex matched to every right vertex
+	for i := range matchLeft {
+		matchLeft[i] = unmatched
+	}
+	for j := range matchRight {
+		matchRight[j] = unmatched
+	}
+
+	layer := make([]int, len(b.adjacency))
+	next := make([]int, len(b.adjacency)) // first edge of every left vertex not tried yet
+	limit := unmatched                    // length of the shortest augmenting paths
+
+	// layers finds the length of the shortest alternating paths from free left vertices,
+	// stopping at the layer where the first free right vertex is reached, and reports if
+	// there is any
+	layers := func() bool {
+		var queue []int
+		for i := range layer {
+			layer[i] = unmatched
+			if matchLeft[i] == unmatched {
+				layer[i] = 0
+				queue = append(queue, i)
+			}
+		}
+
+		limit = unmatched
+		for len(queue) > 0 {
+			i := queue[0]
+			queue = queue[1:]
+			if limit != unmatched && layer[i]+1 >= limit {
+				continue // longer paths are left for the next phases
+			}
+			for _, j := range b.adjacency[i] {
+				mate := matchRight[j]
+				if mate == unmatched {
+					if limit == unmatched {
+						limit = layer[i] + 1
+					}
+				} else if layer[mate] == unmatched {
+					layer[mate] = layer[i] + 1
+					queue = append(queue, mate)
+				}
+			}
+		}
+		return limit != unmatched
+	}
+
+	// augment follows the layers from the left vertex i to a free right vertex at the
+	// shortest distance, flipping the edges of the path
+	var augment func(i int) bool
+	augment = func(i int) bool {
+		for ; next[i] < len(b.adjacency[i]); next[i]++ {
+			j := b.adjacency[i][next[i]]
+			mate := matchRight[j]
+			free := mate == unmatched && layer[i]+1 == limit
+			if free || (mate != unmatched && layer[mate] == layer[i]+1 && augment(mate)) {
+				matchLeft[i], matchRight[j] = j, i
+				next[i]++
+				return true
+			}
+		}
+
+		layer[i] = unmatched
+		return false
+	}
+
+	phases := 0
+	for layers() {
+		phases++
+		for i := range next {
+			next[i] = 0
+		}
+		for i := range matchLeft {
+			if matchLeft[i] == unmatched {
+				augment(i)
+			}
+		}
+	}
+
+	return matchLeft, phases
+}
+
+// Hungarian finds an assignment of minimum total weight between the left and the right
+// vertices of a graph, matching every vertex of the smaller side, in O(n²·m) for n
+// vertices on the smaller side and m on the other one. It reads the edges going from left
+// to right as a dense cost matrix where missing edges cannot be used. To maximize the
+// weight, negate it. It returns the assigned edges, each going from left to right, and
+// their total weight.
+// If the edges cannot match every vertex of the smaller side, retrieve an
+// ErrNoCompleteAssignment; if a vertex is listed twice or an edge joins two vertices of
+// the same side, an ErrNotBipartite; if a vertex is not in the graph, an ErrVertexNotFound
+func Hungarian[T comparable, W Numeric](g Graph[T, W], left, right []T) ([]Edge[T, W], W, error) {
+	b, err := newBipartiteGraph(g, left, right)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// rows are the smaller side, so every row gets a column
+	transposed := len(left) > len(right)
+	rows, columns := len(left), len(right)
+	if transposed {
+		rows, columns = columns, rows
+	}
+
+	cost := make([][]W, rows)
+	allowed := make([][]bool, rows)
+	for r := range cost {
+		cost[r] = make([]W, columns)
+		allowed[r] = make([]bool, columns)
+	}
+	for i, adjacent := range b.adjacency {
+		for k, j := range adjacent {
+			r, c := i, j
+			if transposed {
+				r, c = j, i
+			}
+			cost[r][c], allowed[r][c] = b.weights[i][k], true
+		}
+	}
+
+	assigned, err := hungarian(cost, allowed)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	match := make([]int, len(left)) // right vertex assigned to every left vertex
+	for i := range match {
+		match[i] = -1
+	}
+	for r, c := range assigned {
+		if transposed {
+			match[c] = r
+		} else {
+			match[r] = c
+		}
+	}
+
+	var assignment []Edge[T, W]
+	var total W
+	for i, j := range match {
+		if j == -1 {
+			continue
+		}
+		r, c := i, j
+		if transposed {
+			r, c = j, i
+		}
+		assignment = append(assignment, Edge[T, W]{From: left[i], To: right[j], Weight: cost[r][c]})
+		total += cost[r][c]
+	}
+
+	return assignment, total, nil
+}
+
+// hungarian assigns a column to every row of a cost matrix with no more rows than
+// columns, lowering the potentials of rows and columns until a row can reach a free
+// column through edges of zero reduced cost. It returns the column of every row
+func hungarian[W Numeric](cost [][]W, allowed [][]bool) ([]int, error) {
+	rows, columns := len(cost), 0
+	if rows > 0 {
+		columns = len(cost[0])
+	}
+
+	// row and column 0 are virtual, so the matrix is read from index 1
+	rowPotential := make([]W, rows+1)
+	columnPotential := make([]W, columns+1)
+	owner := make([]int, columns+1) // row assigned to every column, 0 when free
+	way := make([]int, columns+1)   // previous column on the alternating path
+	slack := make([]W, columns+1)
+	hasSlack := make([]bool, columns+1)
+	used := make([]bool, columns+1)
+
+	for row := 1; row <= rows; row++ {
+		owner[0] = row
+		column := 0
+		for c := range used {
+			used[c], hasSlack[c] = false, false
+		}
+
+		for owner[column] != 0 {
+			used[column] = true
+			r := owner[column]
+			delta, nextColumn := W(0), -1
+
+			for c := 1; c <= columns; c++ {
+				if used[c] {
+					continue
+				}
+
+				if allowed[r-1][c-1] {
+					reduced := cost[r-1][c-1] - rowPotential[r] - columnPotential[c]
+					if !hasSlack[c] || reduced < slack[c] {
+						slack[c], hasSlack[c], way[c] = reduced, true, column
+					}
+				}
+				if hasSlack[c] && (nextColumn == -1 || slack[c] < delta) {
+					delta, nextColumn = slack[c], c
+				}
+			}
+
+			if nextColumn == -1 {
+				return nil, ErrNoCompleteAssignment
+			}
+
+			for c := 0; c <= columns; c++ {
+				if used[c] {
+					rowPotential[owner[c]] += delta
+					columnPotential[c] -= delta
+				} else if hasSlack[c] {
+					slack[c] -= delta
+				}
+			}
+			column = nextColumn
+		}
+
+		// flip the alternating path ending on the free column
+		for column != 0 {
+			previous := way[column]
+			owner[column] = owner[previous]
+			column = previous
+		}
+	}
+
+	assigned := make([]int, rows)
+	for c := 1; c <= columns; c++ {
+		if owner[c] != 0 {
+			assigned[owner[c]-1] = c - 1
+		}
+	}
+
+	return assigned, nil
+}
@@ -0,0 +1,418 @@
+package structures_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// checkBipartition fails the test if the sides do not split every vertex of the graph
+// so every edge joins both sides
+func checkBipartition(t *testing.T, g structures.Graph[string, int], left, right []string) {
+	t.Helper()
+
+	onLeft := make(map[string]bool)
+	for _, vertex := range left {
+		onLeft[vertex] = true
+	}
+	for _, vertex := range right {
+		if onLeft[vertex] {
+			t.Fatalf("%v is on both sides", vertex)
+		}
+	}
+	if len(left)+len(right) != len(g.Vertices()) {
+		t.Fatalf("expected %d vertices, got %v and %v", len(g.Vertices()), left, right)
+	}
+
+	for _, edge := range g.Edges() {
+		if onLeft[edge.From] == onLeft[edge.To] {
+			t.Fatalf("edge %v joins vertices of the same side", edge)
+		}
+	}
+}
+
+// checkOddCycle fails the test if the error is not an *OddCycleError with a closed cycle
+// of odd length over edges of the graph
+func checkOddCycle(t *testing.T, g structures.Graph[string, int], err error) {
+	t.Helper()
+
+	var cycleErr *structures.OddCycleError[string]
+	if !errors.Is(err, structures.ErrNotBipartite) || !errors.As(err, &cycleErr) {
+		t.Fatalf("expected an OddCycleError, got %v", err)
+	}
+
+	cycle := cycleErr.Cycle
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] || (len(cycle)-1)%2 == 0 {
+		t.Fatalf("expected a closed cycle of odd length, got %v", cycle)
+	}
+	for i := 0; i < len(cycle)-1; i++ {
+		if _, err := g.Weight(cycle[i], cycle[i+1]); err != nil {
+			t.Fatalf("expected edge %v - %v in cycle %v", cycle[i], cycle[i+1], cycle)
+		}
+	}
+}
+
+// checkMatching fails the test if the edges are not edges of the graph from left to
+// right sharing no vertex
+func checkMatching(t *testing.T, g structures.Graph[string, int], left []string, matching []structures.Edge[string, int]) {
+	t.Helper()
+
+	isLeft := make(map[string]bool)
+	for _, vertex := range left {
+		isLeft[vertex] = true
+	}
+
+	matched := make(map[string]bool)
+	for _, edge := range matching {
+		weight, err := g.Weight(edge.From, edge.To)
+		if err != nil || weight != edge.Weight || !isLeft[edge.From] {
+			t.Fatalf("expected an edge from left to right, got %v", edge)
+		}
+		if matched[edge.From] || matched[edge.To] {
+			t.Fatalf("edge %v shares a vertex with another edge", edge)
+		}
+		matched[edge.From], matched[edge.To] = true, true
+	}
+}
+
+// bipartiteVertices adds n left vertices and m right vertices to the graph
+func bipartiteVertices(t *testing.T, g structures.Graph[string, int], n, m int) ([]string, []string) {
+	t.Helper()
+
+	left, right := make([]string, n), make([]string, m)
+	for i := range left {
+		left[i] = fmt.Sprintf("l%d", i)
+		addVertices(t, g, left[i])
+	}
+	for j := range right {
+		right[j] = fmt.Sprintf("r%d", j)
+		addVertices(t, g, right[j])
+	}
+	return left, right
+}
+
+func TestIsBipartite(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b", "c", "d", "e", "f")
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("c", "d", 1)
+		g.AddEdge("d", "a", 1)
+		g.AddEdge("e", "a", 1)
+
+		left, right, err := structures.IsBipartite(g)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		checkBipartition(t, g, left, right)
+	})
+}
+
+func TestIsBipartite_OddCycle(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b", "c", "d", "e", "f")
+		g.AddEdge("f", "a", 1)
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("c", "d", 1)
+		g.AddEdge("d", "e", 1)
+		g.AddEdge("e", "a", 1)
+
+		_, _, err := structures.IsBipartite(g)
+		checkOddCycle(t, g, err)
+	})
+}
+
+func TestIsBipartite_SelfLoop(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b")
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "b", 1)
+
+		_, _, err := structures.IsBipartite(g)
+		checkOddCycle(t, g, err)
+	})
+}
+
+func TestIsBipartite_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		for _, constructor := range graphConstructors {
+			g := constructor.newGraph(false)
+			vertices := make([]string, 10)
+			for i := range vertices {
+				vertices[i] = string(rune('a' + i))
+				g.AddVertex(vertices[i])
+			}
+			for i := 0; i < 8; i++ {
+				g.AddEdge(vertices[rnd.Intn(10)], vertices[rnd.Intn(10)], 1)
+			}
+
+			left, right, err := structures.IsBipartite(g)
+			if err != nil {
+				checkOddCycle(t, g, err)
+				continue
+			}
+			checkBipartition(t, g, left, right)
+		}
+	}
+}
+
+func TestIsBipartite_Directed(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](true)
+	if _, _, err := structures.IsBipartite(g); !errors.Is(err, structures.ErrDirectedGraph) {
+		t.Fatalf("expected ErrDirectedGraph, got %v", err)
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 4, 4)
+		g.AddEdge("l0", "r0", 1)
+		g.AddEdge("l0", "r1", 1)
+		g.AddEdge("l1", "r0", 1)
+		g.AddEdge("l2", "r0", 1)
+		g.AddEdge("l2", "r2", 1)
+		g.AddEdge("l2", "r3", 1)
+		g.AddEdge("l3", "r0", 1)
+
+		matching, err := structures.HopcroftKarp(g, left, right)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(matching) != 3 {
+			t.Fatalf("expected 3 matched edges, got %v", matching)
+		}
+		checkMatching(t, g, left, matching)
+	})
+}
+
+func TestHopcroftKarp_ShortestPathsOnly(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 5, 5)
+		g.AddEdge("l0", "r2", 1)
+		g.AddEdge("l0", "r3", 1)
+		g.AddEdge("l1", "r2", 1)
+		g.AddEdge("l1", "r4", 1)
+		g.AddEdge("l2", "r0", 1)
+		g.AddEdge("l2", "r1", 1)
+		g.AddEdge("l3", "r0", 1)
+		g.AddEdge("l4", "r4", 1)
+
+		// the first phase matches l0-r2, l1-r4 and l2-r0. Then l3 has an augmenting path
+		// of length 3 through r0 and l2, and l4 a disjoint one of length 5 through r4, l1,
+		// r2 and l0, which must wait for a third phase
+		phases, err := structures.HopcroftKarpPhases(g, left, right)
+		if err != nil || phases != 3 {
+			t.Fatalf("expected 3 phases, got %d (error: %v)", phases, err)
+		}
+
+		matching, _ := structures.HopcroftKarp(g, left, right)
+		if len(matching) != 5 {
+			t.Fatalf("expected 5 matched edges, got %v", matching)
+		}
+		checkMatching(t, g, left, matching)
+	})
+}
+
+func TestHopcroftKarp_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+			n, m := 1+rnd.Intn(8), 1+rnd.Intn(8)
+			left, right := bipartiteVertices(t, g, n, m)
+
+			network := structures.NewAdjacencyListGraph[string, int](true)
+			addVertices(t, network, append([]string{"s", "t"}, append(left, right...)...)...)
+			for _, vertex := range left {
+				network.AddEdge("s", vertex, 1)
+			}
+			for _, vertex := range right {
+				network.AddEdge(vertex, "t", 1)
+			}
+			for i := 0; i < n*m/2; i++ {
+				from, to := left[rnd.Intn(n)], right[rnd.Intn(m)]
+				g.AddEdge(from, to, 1)
+				network.AddEdge(from, to, 1)
+			}
+
+			matching, err := structures.HopcroftKarp(g, left, right)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			checkMatching(t, g, left, matching)
+
+			flow, _ := structures.MaxFlow(network, "s", "t")
+			if len(matching) != flow.Value {
+				t.Fatalf("expected %d matched edges, got %v", flow.Value, matching)
+			}
+		})
+	}
+}
+
+func TestHopcroftKarp_Errors(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 2, 2)
+		g.AddEdge("l0", "r0", 1)
+
+		if _, err := structures.HopcroftKarp(g, left, []string{"r0", "l0"}); !errors.Is(err, structures.ErrNotBipartite) {
+			t.Fatalf("expected ErrNotBipartite for a vertex listed twice, got %v", err)
+		}
+		if _, err := structures.HopcroftKarp(g, left, []string{"z"}); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("expected ErrVertexNotFound, got %v", err)
+		}
+
+		g.AddEdge("l0", "l1", 1)
+		if _, err := structures.HopcroftKarp(g, left, right); !errors.Is(err, structures.ErrNotBipartite) {
+			t.Fatalf("expected ErrNotBipartite for an edge inside a side, got %v", err)
+		}
+	})
+}
+
+// cheapestAssignment tries every assignment of a column to every row, with no more rows
+// than columns, and returns the lowest total cost
+func cheapestAssignment(cost [][]int, allowed [][]bool) (int, bool) {
+	best, found := 0, false
+	used := make([]bool, len(cost[0]))
+
+	var assign func(row, total int)
+	assign = func(row, total int) {
+		if row == len(cost) {
+			if !found || total < best {
+				best, found = total, true
+			}
+			return
+		}
+		for c := range used {
+			if !used[c] && allowed[row][c] {
+				used[c] = true
+				assign(row+1, total+cost[row][c])
+				used[c] = false
+			}
+		}
+	}
+	assign(0, 0)
+
+	return best, found
+}
+
+func TestHungarian(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 3, 3)
+		costs := [][]int{
+			{4, 1, 3},
+			{2, 0, 5},
+			{3, 2, 2},
+		}
+		for i, row := range costs {
+			for j, cost := range row {
+				g.AddEdge(left[i], right[j], cost)
+			}
+		}
+
+		assignment, total, err := structures.Hungarian(g, left, right)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total != 5 || len(assignment) != 3 {
+			t.Fatalf("expected 3 edges costing 5, got %v costing %d", assignment, total)
+		}
+		checkMatching(t, g, left, assignment)
+		if assignment[0].From != "l0" || assignment[0].To != "r1" {
+			t.Fatalf("expected assignments in the order of the left side, got %v", assignment)
+		}
+	})
+}
+
+func TestHungarian_Rectangular(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 2, 3)
+		g.AddEdge("l0", "r0", 7)
+		g.AddEdge("l0", "r1", 3)
+		g.AddEdge("l0", "r2", 5)
+		g.AddEdge("l1", "r0", 2)
+		g.AddEdge("l1", "r1", 1)
+
+		// l1 leaves its cheapest edge to l0, which saves more
+		assignment, total, err := structures.Hungarian(g, left, right)
+		if err != nil || total != 5 || len(assignment) != 2 {
+			t.Fatalf("expected 2 edges costing 5, got %v costing %d (error: %v)", assignment, total, err)
+		}
+		checkMatching(t, g, left, assignment)
+
+		// with the sides swapped, every vertex of the right side is matched
+		swapped, total, err := structures.Hungarian(g, right, left)
+		if err != nil || total != 5 || len(swapped) != 2 {
+			t.Fatalf("expected 2 edges costing 5, got %v costing %d (error: %v)", swapped, total, err)
+		}
+		checkMatching(t, g, right, swapped)
+	})
+}
+
+func TestHungarian_NoCompleteAssignment(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		left, right := bipartiteVertices(t, g, 2, 2)
+		g.AddEdge("l0", "r0", 1)
+		g.AddEdge("l1", "r0", 1)
+
+		if _, _, err := structures.Hungarian(g, left, right); !errors.Is(err, structures.ErrNoCompleteAssignment) {
+			t.Fatalf("expected ErrNoCompleteAssignment, got %v", err)
+		}
+	})
+}
+
+func TestHungarian_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 100; round++ {
+		forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+			n, m := 1+rnd.Intn(5), 1+rnd.Intn(5)
+			left, right := bipartiteVertices(t, g, n, m)
+
+			rows, columns := n, m
+			if n > m {
+				rows, columns = m, n
+			}
+			cost := make([][]int, rows)
+			allowed := make([][]bool, rows)
+			for r := range cost {
+				cost[r] = make([]int, columns)
+				allowed[r] = make([]bool, columns)
+			}
+			for i := range left {
+				for j := range right {
+					if rnd.Intn(4) == 0 {
+						continue
+					}
+					weight := rnd.Intn(21) - 5
+					g.AddEdge(left[i], right[j], weight)
+
+					r, c := i, j
+					if n > m {
+						r, c = j, i
+					}
+					cost[r][c], allowed[r][c] = weight, true
+				}
+			}
+
+			assignment, total, err := structures.Hungarian(g, left, right)
+			best, found := cheapestAssignment(cost, allowed)
+			if !found {
+				if !errors.Is(err, structures.ErrNoCompleteAssignment) {
+					t.Fatalf("expected ErrNoCompleteAssignment, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if total != best || len(assignment) != rows {
+				t.Fatalf("expected %d edges costing %d, got %v costing %d", rows, best, assignment, total)
+			}
+			checkMatching(t, g, left, assignment)
+		})
+	}
+}
@@ -65,11 +65,19 @@ func NumericZeroValue[W Numeric]() W {
 	return zero
 }
 
-// reconstructPathOfGraph reconstructs the path from the 'previous' map.
-func reconstructPathOfGraph[T comparable](previous map[T]*T, to T) []T {
-	var path []T
-	for at := &to; at != nil; at = previous[*at] {
-		path = append([]T{*at}, path...)
+// NumericEpsilon returns the tolerance used to compare values of the numeric type W,
+// which is zero for integers.
+func NumericEpsilon[W Numeric]() W {
+	var zero W
+
+	switch any(zero).(type) {
+	case float32:
+		var a float32 = 1e-6
+		return W(a)
+	case float64:
+		var a float64 = 1e-9
+		return W(a)
+	default:
+		return zero
 	}
-	return path
 }
@@ -0,0 +1,213 @@
+package structures
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrQueueClosed tolds the queue was closed
+var (
+	ErrQueueClosed = errors.New("closed queue")
+)
+
+// concurrentQueue concrete implementation safe for concurrent use.
+// Waiters block on notEmpty and notFull channels, so they can also listen to the
+// cancellation of a context. A channel is closed and replaced when an element
+// enters or leaves the queue only if someone is waiting on it
+type concurrentQueue[T comparable] struct {
+	mu           sync.Mutex
+	items        RingQueuer[T]
+	closed       bool
+	notEmpty     chan struct{}
+	notFull      chan struct{}
+	emptyWaiters int
+	fullWaiters  int
+}
+
+// NewConcurrentQueue retrieve an empty queue safe for concurrent use that holds at
+// most capacity elements. A capacity lower than 1 creates an unbounded queue
+func NewConcurrentQueue[T comparable](capacity int) ConcurrentQueuer[T] {
+	var items RingQueuer[T]
+	if capacity < 1 {
+		items = NewRingQueue[T](0, WithShrink())
+	} else {
+		items = NewRingQueue[T](capacity, WithFixedCapacity())
+	}
+
+	return &concurrentQueue[T]{
+		items:    items,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Pop retrieves the element at the top of the queue without waiting, use PopCtx to wait
+// If queue is empty, retrieve an ErrEmptyQueue, or an ErrQueueClosed if it is also closed
+func (q *concurrentQueue[T]) Pop() (T, error) {
+	return q.TryPop()
+}
+
+// PopCtx retrieves the element at the top of the queue, waiting until there is
+// one or the context is done
+// If queue is closed and empty, retrieve an ErrQueueClosed
+func (q *concurrentQueue[T]) PopCtx(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		value, err := q.pop()
+		if !errors.Is(err, ErrEmptyQueue) {
+			q.mu.Unlock()
+			return value, err
+		}
+		wait := q.notEmpty
+		q.emptyWaiters++
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var t T
+			return t, ctx.Err()
+		}
+	}
+}
+
+// TryPop retrieves the element at the top of the queue without waiting
+// If queue is empty, retrieve an ErrEmptyQueue, or an ErrQueueClosed if it is also closed
+func (q *concurrentQueue[T]) TryPop() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.pop()
+}
+
+// pop removes the element at the top of the queue, it must be called holding the lock
+func (q *concurrentQueue[T]) pop() (T, error) {
+	value, err := q.items.Pop()
+	if err != nil {
+		if q.closed {
+			return value, ErrQueueClosed
+		}
+		return value, err
+	}
+
+	if q.fullWaiters > 0 {
+		close(q.notFull)
+		q.notFull = make(chan struct{})
+		q.fullWaiters = 0
+	}
+
+	return value, nil
+}
+
+// Push allows to insert an element at the bottom of the queue, waiting until there
+// is room for it
+// If queue is closed, the element is discarded, use PushCtx or TryPush to know it
+func (q *concurrentQueue[T]) Push(value T) {
+	q.PushCtx(context.Background(), value)
+}
+
+// PushCtx inserts an element at the bottom of the queue, waiting until there is room
+// for it or the context is done
+// If queue is closed, retrieve an ErrQueueClosed
+func (q *concurrentQueue[T]) PushCtx(ctx context.Context, value T) error {
+	for {
+		q.mu.Lock()
+		err := q.push(value)
+		if !errors.Is(err, ErrQueueFull) {
+			q.mu.Unlock()
+			return err
+		}
+		wait := q.notFull
+		q.fullWaiters++
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// TryPush inserts an element at the bottom of the queue without waiting
+// If queue is full, retrieve an ErrQueueFull, if it is closed, retrieve an ErrQueueClosed
+func (q *concurrentQueue[T]) TryPush(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.push(value)
+}
+
+// push inserts an element at the bottom of the queue, it must be called holding the lock
+func (q *concurrentQueue[T]) push(value T) error {
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	if err := q.items.TryPush(value); err != nil {
+		return err
+	}
+
+	if q.emptyWaiters > 0 {
+		close(q.notEmpty)
+		q.notEmpty = make(chan struct{})
+		q.emptyWaiters = 0
+	}
+
+	return nil
+}
+
+// Close stops the queue from accepting new elements and wakes up every waiter.
+// The elements already on the queue can still be popped, after that Pop and
+// PopCtx retrieve an ErrQueueClosed. Closing a closed queue has no effect
+func (q *concurrentQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	close(q.notEmpty)
+	close(q.notFull)
+	q.notEmpty = make(chan struct{})
+	q.notFull = make(chan struct{})
+	q.emptyWaiters = 0
+	q.fullWaiters = 0
+}
+
+// Find check if an element exists on the queue
+func (q *concurrentQueue[T]) Find(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Find(value)
+}
+
+// Top retrieves a copy of the element at the top of the queue without waiting
+// If queue is empty, retrieve an ErrEmptyQueue
+func (q *concurrentQueue[T]) Top() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Top()
+}
+
+// Bottom retrieves a copy of the element at the bottom of the queue without waiting
+// If queue is empty, retrieve an ErrEmptyQueue
+func (q *concurrentQueue[T]) Bottom() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Bottom()
+}
+
+// Size retrieves the the quantity of elements on the queue
+func (q *concurrentQueue[T]) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Size()
+}
@@ -0,0 +1,337 @@
+package structures_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewConcurrentQueue(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](2)
+
+	if q.Size() != 0 {
+		t.Fatalf("NewConcurrentQueue: Expected 0, got %v", q.Size())
+	}
+}
+
+func TestConcurrentQueue_PushPop(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](3)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	if q.Size() != 3 {
+		t.Fatalf("ConcurrentQueue.Push: Expected 3, got %v", q.Size())
+	}
+
+	top, _ := q.Top()
+	bottom, _ := q.Bottom()
+	if top != 1 || bottom != 3 {
+		t.Fatalf("ConcurrentQueue: Expected top 1 and bottom 3, got %v and %v", top, bottom)
+	}
+
+	if !q.Find(2) || q.Find(4) {
+		t.Fatalf("ConcurrentQueue.Find: Expected to find only pushed elements")
+	}
+
+	for _, expected := range []int{1, 2, 3} {
+		v, err := q.Pop()
+		if err != nil {
+			t.Fatalf("ConcurrentQueue.Pop: Expected nil, got %v", err)
+		}
+		if v != expected {
+			t.Fatalf("ConcurrentQueue.Pop: Expected %v, got %v", expected, v)
+		}
+	}
+}
+
+func TestConcurrentQueue_TryPush_Full(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+
+	if err := q.TryPush(1); err != nil {
+		t.Fatalf("ConcurrentQueue.TryPush: Expected nil, got %v", err)
+	}
+
+	if err := q.TryPush(2); !errors.Is(err, structures.ErrQueueFull) {
+		t.Fatalf("ConcurrentQueue.TryPush: Expected ErrQueueFull, got %v", err)
+	}
+}
+
+func TestConcurrentQueue_TryPop_Empty(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+
+	if _, err := q.TryPop(); !errors.Is(err, structures.ErrEmptyQueue) {
+		t.Fatalf("ConcurrentQueue.TryPop: Expected ErrEmptyQueue, got %v", err)
+	}
+}
+
+func TestConcurrentQueue_Unbounded(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](0)
+
+	for i := 0; i < 1000; i++ {
+		if err := q.TryPush(i); err != nil {
+			t.Fatalf("ConcurrentQueue.TryPush: Expected nil, got %v", err)
+		}
+	}
+
+	if q.Size() != 1000 {
+		t.Fatalf("ConcurrentQueue.TryPush: Expected 1000, got %v", q.Size())
+	}
+}
+
+func TestConcurrentQueue_PopCtx_Cancelled(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.PopCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ConcurrentQueue.PopCtx: Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestConcurrentQueue_PushCtx_Cancelled(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+	q.Push(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := q.PushCtx(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ConcurrentQueue.PushCtx: Expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if q.Size() != 1 {
+		t.Fatalf("ConcurrentQueue.PushCtx: Expected 1, got %v", q.Size())
+	}
+}
+
+func TestConcurrentQueue_PushCtx_WaitsForRoom(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+	q.Push(1)
+
+	done := make(chan error)
+	go func() {
+		done <- q.PushCtx(context.Background(), 2)
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("ConcurrentQueue.PushCtx: Expected to block, returned %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if v, _ := q.Pop(); v != 1 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 1, got %v", v)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("ConcurrentQueue.PushCtx: Expected nil, got %v", err)
+	}
+
+	if v, _ := q.Pop(); v != 2 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 2, got %v", v)
+	}
+}
+
+func TestConcurrentQueue_Close_Drains(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](2)
+	q.Push(1)
+	q.Push(2)
+	q.Close()
+	q.Close()
+
+	if err := q.TryPush(3); !errors.Is(err, structures.ErrQueueClosed) {
+		t.Fatalf("ConcurrentQueue.TryPush: Expected ErrQueueClosed, got %v", err)
+	}
+
+	for _, expected := range []int{1, 2} {
+		v, err := q.Pop()
+		if err != nil || v != expected {
+			t.Fatalf("ConcurrentQueue.Pop: Expected %v, got %v (error: %v)", expected, v, err)
+		}
+	}
+
+	if _, err := q.Pop(); !errors.Is(err, structures.ErrQueueClosed) {
+		t.Fatalf("ConcurrentQueue.Pop: Expected ErrQueueClosed, got %v", err)
+	}
+
+	if _, err := q.TryPop(); !errors.Is(err, structures.ErrQueueClosed) {
+		t.Fatalf("ConcurrentQueue.TryPop: Expected ErrQueueClosed, got %v", err)
+	}
+}
+
+func TestConcurrentQueue_Close_WakesWaiters(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := q.PopCtx(context.Background())
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if !errors.Is(err, structures.ErrQueueClosed) {
+			t.Fatalf("ConcurrentQueue.PopCtx: Expected ErrQueueClosed, got %v", err)
+		}
+	}
+}
+
+func TestConcurrentQueue_Push_WaitsForRoom(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+	q.Push(1)
+
+	done := make(chan struct{})
+	go func() {
+		q.Push(2)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("ConcurrentQueue.Push: Expected to block on a full queue")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if v, _ := q.Pop(); v != 1 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 1, got %v", v)
+	}
+	<-done
+
+	if v, _ := q.Pop(); v != 2 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 2, got %v", v)
+	}
+}
+
+func TestConcurrentQueue_Push_Closed_Discards(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+	q.Push(1)
+
+	done := make(chan struct{})
+	go func() {
+		q.Push(2)
+		close(done)
+	}()
+
+	// closing wakes up the waiting Push, which discards its element
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	<-done
+	q.Push(3)
+
+	if q.Size() != 1 {
+		t.Fatalf("ConcurrentQueue.Push: Expected 1, got %v", q.Size())
+	}
+	if v, err := q.Pop(); err != nil || v != 1 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 1, got %v (error: %v)", v, err)
+	}
+}
+
+func TestConcurrentQueue_Pop_Empty(t *testing.T) {
+	q := structures.NewConcurrentQueue[int](1)
+
+	// draining through the Queuer interface must not block
+	var queue structures.Queuer[int] = q
+	q.Push(1)
+	popped := 0
+	for {
+		if _, err := queue.Pop(); err != nil {
+			if !errors.Is(err, structures.ErrEmptyQueue) {
+				t.Fatalf("ConcurrentQueue.Pop: Expected ErrEmptyQueue, got %v", err)
+			}
+			break
+		}
+		popped++
+	}
+
+	if popped != 1 {
+		t.Fatalf("ConcurrentQueue.Pop: Expected 1 element, got %v", popped)
+	}
+}
+
+func TestConcurrentQueue_ManyProducersAndConsumers(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 2000
+
+	q := structures.NewConcurrentQueue[int](16)
+
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func(p int) {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.PushCtx(context.Background(), p*perProducer+i); err != nil {
+					t.Errorf("ConcurrentQueue.PushCtx: Expected nil, got %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+
+	var consumersWg sync.WaitGroup
+	results := make(chan []int, consumers)
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			var received []int
+			for {
+				v, err := q.PopCtx(context.Background())
+				if errors.Is(err, structures.ErrQueueClosed) {
+					results <- received
+					return
+				}
+				if err != nil {
+					t.Errorf("ConcurrentQueue.PopCtx: Expected nil, got %v", err)
+					results <- received
+					return
+				}
+				received = append(received, v)
+			}
+		}()
+	}
+
+	producersWg.Wait()
+	q.Close()
+	consumersWg.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for received := range results {
+		lastByProducer := make(map[int]int)
+		for _, v := range received {
+			if seen[v] {
+				t.Fatalf("ConcurrentQueue: value %v received twice", v)
+			}
+			seen[v] = true
+
+			// every consumer must see the values of a producer in push order
+			p := v / perProducer
+			if last, ok := lastByProducer[p]; ok && last > v {
+				t.Fatalf("ConcurrentQueue: value %v received after %v", v, last)
+			}
+			lastByProducer[p] = v
+		}
+	}
+
+	if len(seen) != producers*perProducer {
+		t.Fatalf("ConcurrentQueue: Expected %v values, got %v", producers*perProducer, len(seen))
+	}
+}
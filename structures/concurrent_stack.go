@@ -0,0 +1,100 @@
+package structures
+
+import "sync/atomic"
+
+// concurrentStack is a lock-free Treiber stack. The top of the stack is swapped
+// with compare-and-swap and nodes are never modified once they are published,
+// so a reader that loaded a top node can walk the rest of the stack safely.
+//
+// The ABA problem does not apply here: a node is never reused while a goroutine
+// still holds a reference to it, because the garbage collector only reclaims
+// nodes that nobody can reach, so a successful compare-and-swap always means
+// the top did not change
+type concurrentStack[T comparable] struct {
+	top  atomic.Pointer[node[T]]
+	size atomic.Int64
+}
+
+// NewConcurrentStack retrieve an empty lock-free stack safe for concurrent use
+func NewConcurrentStack[T comparable]() Stacker[T] {
+	return &concurrentStack[T]{}
+}
+
+// Pop retrieves the element at the top of the stack
+// If stack is empty, retrieve an ErrEmptyStack
+func (s *concurrentStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var t T
+			return t, ErrEmptyStack
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+// Push allows to insert an element at the top the stack
+func (s *concurrentStack[T]) Push(value T) {
+	newTop := &node[T]{value: value}
+
+	for {
+		top := s.top.Load()
+		newTop.next = top
+
+		if s.top.CompareAndSwap(top, newTop) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+// Find check if an element exists on the stack
+// The search runs over a snapshot of the stack taken when it starts
+func (s *concurrentStack[T]) Find(value T) bool {
+	for current := s.top.Load(); current != nil; current = current.next {
+		if current.value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Top retrieves a copy of the element at the top of the stack
+// If stack is empty, retrieve an ErrEmptyStack
+func (s *concurrentStack[T]) Top() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var t T
+		return t, ErrEmptyStack
+	}
+
+	return top.value, nil
+}
+
+// Bottom retrieves a copy of the element at the bottom of the stack
+// The bottom is read from a snapshot of the stack taken when it starts
+// If stack is empty, retrieve an ErrEmptyStack
+func (s *concurrentStack[T]) Bottom() (T, error) {
+	current := s.top.Load()
+	if current == nil {
+		var t T
+		return t, ErrEmptyStack
+	}
+
+	for current.next != nil {
+		current = current.next
+	}
+
+	return current.value, nil
+}
+
+// Size retrieves the the quantity of elements on the stack
+// While other goroutines push or pop, the size may lag behind the real content
+func (s *concurrentStack[T]) Size() int64 {
+	return s.size.Load()
+}
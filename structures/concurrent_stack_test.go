@@ -0,0 +1,204 @@
+package structures_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewConcurrentStack(t *testing.T) {
+	s := structures.NewConcurrentStack[int]()
+
+	if s.Size() != 0 {
+		t.Fatalf("NewConcurrentStack: Expected 0, got %v", s.Size())
+	}
+}
+
+func TestConcurrentStack_PushPop(t *testing.T) {
+	s := structures.NewConcurrentStack[int]()
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	if s.Size() != 3 {
+		t.Fatalf("ConcurrentStack.Push: Expected 3, got %v", s.Size())
+	}
+
+	for _, expected := range []int{3, 2, 1} {
+		v, err := s.Pop()
+		if err != nil {
+			t.Fatalf("ConcurrentStack.Pop: Expected nil, got %v", err)
+		}
+		if v != expected {
+			t.Fatalf("ConcurrentStack.Pop: Expected %v, got %v", expected, v)
+		}
+	}
+
+	if _, err := s.Pop(); !errors.Is(err, structures.ErrEmptyStack) {
+		t.Fatalf("ConcurrentStack.Pop: Expected ErrEmptyStack, got %v", err)
+	}
+}
+
+func TestConcurrentStack_TopBottom(t *testing.T) {
+	s := structures.NewConcurrentStack[int]()
+
+	if _, err := s.Top(); !errors.Is(err, structures.ErrEmptyStack) {
+		t.Fatalf("ConcurrentStack.Top: Expected ErrEmptyStack, got %v", err)
+	}
+	if _, err := s.Bottom(); !errors.Is(err, structures.ErrEmptyStack) {
+		t.Fatalf("ConcurrentStack.Bottom: Expected ErrEmptyStack, got %v", err)
+	}
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	top, _ := s.Top()
+	bottom, _ := s.Bottom()
+	if top != 3 || bottom != 1 {
+		t.Fatalf("ConcurrentStack: Expected top 3 and bottom 1, got %v and %v", top, bottom)
+	}
+}
+
+func TestConcurrentStack_Find(t *testing.T) {
+	s := structures.NewConcurrentStack[int]()
+
+	if s.Find(1) {
+		t.Fatalf("ConcurrentStack.Find: Expected false, got true")
+	}
+
+	s.Push(1)
+	s.Push(2)
+
+	if !s.Find(1) || s.Find(3) {
+		t.Fatalf("ConcurrentStack.Find: Expected to find only pushed elements")
+	}
+}
+
+// TestConcurrentStack_NoLostOrDuplicatedValues stresses the ABA scenario: many
+// goroutines pop a node, push new ones and pop again, so the same top address
+// could be observed twice by a slow goroutine if nodes were recycled. Since
+// nodes are only reclaimed by the garbage collector once unreachable, every value
+// must be popped exactly once
+func TestConcurrentStack_NoLostOrDuplicatedValues(t *testing.T) {
+	const goroutines = 16
+	const perGoroutine = 2000
+
+	s := structures.NewConcurrentStack[int]()
+
+	var wg sync.WaitGroup
+	popped := make(chan int, goroutines*perGoroutine)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Push(g*perGoroutine + i)
+				if i%2 == 1 {
+					for j := 0; j < 2; j++ {
+						if v, err := s.Pop(); err == nil {
+							popped <- v
+						}
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for {
+		v, err := s.Pop()
+		if err != nil {
+			break
+		}
+		popped <- v
+	}
+	close(popped)
+
+	seen := make(map[int]bool)
+	for v := range popped {
+		if seen[v] {
+			t.Fatalf("ConcurrentStack: value %v popped twice", v)
+		}
+		seen[v] = true
+	}
+
+	if len(seen) != goroutines*perGoroutine {
+		t.Fatalf("ConcurrentStack: Expected %v values, got %v", goroutines*perGoroutine, len(seen))
+	}
+
+	if s.Size() != 0 {
+		t.Fatalf("ConcurrentStack.Size: Expected 0, got %v", s.Size())
+	}
+}
+
+func TestConcurrentStack_SnapshotReads(t *testing.T) {
+	s := structures.NewConcurrentStack[int]()
+	s.Push(0)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= 1000; i++ {
+			s.Push(i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			if bottom, err := s.Bottom(); err != nil || bottom != 0 {
+				t.Errorf("ConcurrentStack.Bottom: Expected 0, got %v (error: %v)", bottom, err)
+				return
+			}
+			if !s.Find(0) {
+				t.Errorf("ConcurrentStack.Find: Expected true, got false")
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+// mutexStack wraps the sequential stack behind a mutex to compare against the lock-free one
+type mutexStack[T comparable] struct {
+	mu    sync.Mutex
+	stack structures.Stacker[T]
+}
+
+func (m *mutexStack[T]) Push(value T) {
+	m.mu.Lock()
+	m.stack.Push(value)
+	m.mu.Unlock()
+}
+
+func (m *mutexStack[T]) Pop() (T, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stack.Pop()
+}
+
+func benchmarkStackParallel(b *testing.B, push func(int), pop func() (int, error)) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			push(i)
+			pop()
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentStack_Parallel(b *testing.B) {
+	s := structures.NewConcurrentStack[int]()
+	benchmarkStackParallel(b, s.Push, s.Pop)
+}
+
+func BenchmarkMutexStack_Parallel(b *testing.B) {
+	s := &mutexStack[int]{stack: structures.NewStack[int]()}
+	benchmarkStackParallel(b, s.Push, s.Pop)
+}
@@ -0,0 +1,214 @@
+package structures
+
+import "errors"
+
+// dequeBlockSize is the quantity of elements stored on every block of the deque
+const dequeBlockSize = 64
+
+var (
+	ErrEmptyDeque             = errors.New("empty deque")
+	ErrIndexOutOfRangeInDeque = errors.New("index out of range")
+)
+
+// deque is a double-ended queue that stores its elements on fixed size blocks.
+// The blocks slice works as a map of the blocks, elements live on the positions
+// [start, start+size) of the virtual array formed by joining all the blocks
+type deque[T comparable] struct {
+	blocks [][]T
+	spare  []T
+	start  int
+	size   int64
+}
+
+// NewDeque creates an empty double-ended queue
+func NewDeque[T comparable]() Dequer[T] {
+	return &deque[T]{}
+}
+
+// PushFront inserts an element at the front of the deque
+func (d *deque[T]) PushFront(value T) {
+	if d.start == 0 {
+		d.grow()
+	}
+
+	d.start--
+	d.ensureBlock(d.start)
+	d.set(d.start, value)
+	d.size++
+}
+
+// PushBack inserts an element at the back of the deque
+func (d *deque[T]) PushBack(value T) {
+	end := d.start + int(d.size)
+	if end == len(d.blocks)*dequeBlockSize {
+		d.grow()
+		end = d.start + int(d.size)
+	}
+
+	d.ensureBlock(end)
+	d.set(end, value)
+	d.size++
+}
+
+// PopFront removes and retrieves the element at the front of the deque
+// If deque is empty, retrieve an ErrEmptyDeque
+func (d *deque[T]) PopFront() (T, error) {
+	if d.size == 0 {
+		var t T
+		return t, ErrEmptyDeque
+	}
+
+	value := d.get(d.start)
+	d.clear(d.start)
+	d.start++
+	d.size--
+
+	if d.start%dequeBlockSize == 0 || d.size == 0 {
+		d.releaseBlock(d.start - 1)
+	}
+	d.recenter()
+
+	return value, nil
+}
+
+// PopBack removes and retrieves the element at the back of the deque
+// If deque is empty, retrieve an ErrEmptyDeque
+func (d *deque[T]) PopBack() (T, error) {
+	if d.size == 0 {
+		var t T
+		return t, ErrEmptyDeque
+	}
+
+	last := d.start + int(d.size) - 1
+	value := d.get(last)
+	d.clear(last)
+	d.size--
+
+	if last%dequeBlockSize == 0 || d.size == 0 {
+		d.releaseBlock(last)
+	}
+	d.recenter()
+
+	return value, nil
+}
+
+// Front retrieves a copy of the element at the front of the deque
+// If deque is empty, retrieve an ErrEmptyDeque
+func (d *deque[T]) Front() (T, error) {
+	if d.size == 0 {
+		var t T
+		return t, ErrEmptyDeque
+	}
+
+	return d.get(d.start), nil
+}
+
+// Back retrieves a copy of the element at the back of the deque
+// If deque is empty, retrieve an ErrEmptyDeque
+func (d *deque[T]) Back() (T, error) {
+	if d.size == 0 {
+		var t T
+		return t, ErrEmptyDeque
+	}
+
+	return d.get(d.start + int(d.size) - 1), nil
+}
+
+// At retrieves a copy of the element at index, counting from the front
+func (d *deque[T]) At(index int) (T, error) {
+	if d.size == 0 {
+		var t T
+		return t, ErrEmptyDeque
+	}
+
+	if index < 0 || int(d.size) <= index {
+		var t T
+		return t, ErrIndexOutOfRangeInDeque
+	}
+
+	return d.get(d.start + index), nil
+}
+
+// Find check if an element exists on the deque
+func (d *deque[T]) Find(value T) bool {
+	for i := 0; i < int(d.size); i++ {
+		if d.get(d.start+i) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Size retrieves the quantity of elements on the deque
+func (d *deque[T]) Size() int64 {
+	return d.size
+}
+
+// get retrieves the element at the virtual position
+func (d *deque[T]) get(position int) T {
+	return d.blocks[position/dequeBlockSize][position%dequeBlockSize]
+}
+
+// set stores the element at the virtual position
+func (d *deque[T]) set(position int, value T) {
+	d.blocks[position/dequeBlockSize][position%dequeBlockSize] = value
+}
+
+// clear removes the reference to the element at the virtual position
+func (d *deque[T]) clear(position int) {
+	var zero T
+	d.set(position, zero)
+}
+
+// ensureBlock allocates the block that holds the virtual position if needed
+func (d *deque[T]) ensureBlock(position int) {
+	if d.blocks[position/dequeBlockSize] != nil {
+		return
+	}
+
+	if d.spare != nil {
+		d.blocks[position/dequeBlockSize] = d.spare
+		d.spare = nil
+		return
+	}
+
+	d.blocks[position/dequeBlockSize] = make([]T, dequeBlockSize)
+}
+
+// releaseBlock drops the block that holds the virtual position, keeping it as
+// spare so a deque used as a queue does not allocate on every block crossing
+func (d *deque[T]) releaseBlock(position int) {
+	d.spare = d.blocks[position/dequeBlockSize]
+	d.blocks[position/dequeBlockSize] = nil
+}
+
+// grow makes room on both ends of the map of blocks. The used blocks are moved
+// to the middle of the map, which is doubled only when they fill half of it
+func (d *deque[T]) grow() {
+	firstBlock := d.start / dequeBlockSize
+	used := 0
+	if d.size > 0 {
+		used = (d.start+int(d.size)-1)/dequeBlockSize - firstBlock + 1
+	}
+
+	length := len(d.blocks)
+	if length < 2*used+2 {
+		length = 2*used + 2
+	}
+
+	blocks := make([][]T, length)
+	offset := (length - used) / 2
+	copy(blocks[offset:], d.blocks[firstBlock:firstBlock+used])
+
+	d.start = offset*dequeBlockSize + d.start%dequeBlockSize
+	d.blocks = blocks
+}
+
+// recenter moves an empty deque to the middle of its map, so alternating
+// pushes on both ends do not grow the map
+func (d *deque[T]) recenter() {
+	if d.size == 0 {
+		d.start = (len(d.blocks) / 2) * dequeBlockSize
+	}
+}
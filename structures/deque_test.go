@@ -0,0 +1,242 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewDeque(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	if d.Size() != 0 {
+		t.Fatalf("NewDeque: Expected 0, got %v", d.Size())
+	}
+}
+
+func TestDeque_PushBack(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	d.PushBack(1)
+	d.PushBack(2)
+	d.PushBack(3)
+
+	if d.Size() != 3 {
+		t.Fatalf("Deque.PushBack: Expected 3, got %v", d.Size())
+	}
+
+	front, _ := d.Front()
+	back, _ := d.Back()
+	if front != 1 || back != 3 {
+		t.Fatalf("Deque.PushBack: Expected front 1 and back 3, got %v and %v", front, back)
+	}
+}
+
+func TestDeque_PushFront(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	d.PushFront(1)
+	d.PushFront(2)
+	d.PushFront(3)
+
+	if d.Size() != 3 {
+		t.Fatalf("Deque.PushFront: Expected 3, got %v", d.Size())
+	}
+
+	front, _ := d.Front()
+	back, _ := d.Back()
+	if front != 3 || back != 1 {
+		t.Fatalf("Deque.PushFront: Expected front 3 and back 1, got %v and %v", front, back)
+	}
+}
+
+func TestDeque_PopFront(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	d.PushBack(1)
+	d.PushBack(2)
+	d.PushFront(0)
+
+	for _, expected := range []int{0, 1, 2} {
+		v, err := d.PopFront()
+		if err != nil {
+			t.Fatalf("Deque.PopFront: Expected nil, got %v", err)
+		}
+		if v != expected {
+			t.Fatalf("Deque.PopFront: Expected %v, got %v", expected, v)
+		}
+	}
+}
+
+func TestDeque_PopBack(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	d.PushBack(1)
+	d.PushBack(2)
+	d.PushFront(0)
+
+	for _, expected := range []int{2, 1, 0} {
+		v, err := d.PopBack()
+		if err != nil {
+			t.Fatalf("Deque.PopBack: Expected nil, got %v", err)
+		}
+		if v != expected {
+			t.Fatalf("Deque.PopBack: Expected %v, got %v", expected, v)
+		}
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	if _, err := d.PopFront(); !errors.Is(err, structures.ErrEmptyDeque) {
+		t.Fatalf("Deque.PopFront: Expected ErrEmptyDeque, got %v", err)
+	}
+	if _, err := d.PopBack(); !errors.Is(err, structures.ErrEmptyDeque) {
+		t.Fatalf("Deque.PopBack: Expected ErrEmptyDeque, got %v", err)
+	}
+	if _, err := d.Front(); !errors.Is(err, structures.ErrEmptyDeque) {
+		t.Fatalf("Deque.Front: Expected ErrEmptyDeque, got %v", err)
+	}
+	if _, err := d.Back(); !errors.Is(err, structures.ErrEmptyDeque) {
+		t.Fatalf("Deque.Back: Expected ErrEmptyDeque, got %v", err)
+	}
+	if _, err := d.At(0); !errors.Is(err, structures.ErrEmptyDeque) {
+		t.Fatalf("Deque.At: Expected ErrEmptyDeque, got %v", err)
+	}
+}
+
+func TestDeque_At(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	for i := 0; i < 200; i++ {
+		d.PushBack(i)
+	}
+	for i := 1; i <= 200; i++ {
+		d.PushFront(-i)
+	}
+
+	for i := 0; i < 400; i++ {
+		v, err := d.At(i)
+		if err != nil {
+			t.Fatalf("Deque.At: Expected nil, got %v", err)
+		}
+		if v != i-200 {
+			t.Fatalf("Deque.At: Expected %v, got %v", i-200, v)
+		}
+	}
+
+	if _, err := d.At(400); !errors.Is(err, structures.ErrIndexOutOfRangeInDeque) {
+		t.Fatalf("Deque.At: Expected ErrIndexOutOfRangeInDeque, got %v", err)
+	}
+	if _, err := d.At(-1); !errors.Is(err, structures.ErrIndexOutOfRangeInDeque) {
+		t.Fatalf("Deque.At: Expected ErrIndexOutOfRangeInDeque, got %v", err)
+	}
+}
+
+func TestDeque_Find(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	d.PushBack(1)
+	d.PushFront(2)
+
+	if !d.Find(1) || !d.Find(2) {
+		t.Fatalf("Deque.Find: Expected true, got false")
+	}
+	if d.Find(3) {
+		t.Fatalf("Deque.Find: Expected false, got true")
+	}
+}
+
+func TestDeque_AsQueue(t *testing.T) {
+	d := structures.NewDeque[int]()
+
+	for i := 0; i < 10000; i++ {
+		d.PushBack(i)
+		if i%3 == 2 {
+			d.PopFront()
+			d.PopFront()
+		}
+	}
+
+	front, _ := d.Front()
+	if int64(10000-front) != d.Size() {
+		t.Fatalf("Deque.PopFront: Expected front %v, got %v", 10000-d.Size(), front)
+	}
+}
+
+func TestDeque_RandomOperations(t *testing.T) {
+	d := structures.NewDeque[int]()
+	var expected []int
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		switch rnd.Intn(4) {
+		case 0:
+			d.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case 1:
+			d.PushBack(i)
+			expected = append(expected, i)
+		case 2:
+			v, err := d.PopFront()
+			if len(expected) == 0 {
+				if err == nil {
+					t.Fatalf("Deque.PopFront: Expected ErrEmptyDeque, got nil")
+				}
+				continue
+			}
+			if v != expected[0] {
+				t.Fatalf("Deque.PopFront: Expected %v, got %v", expected[0], v)
+			}
+			expected = expected[1:]
+		case 3:
+			v, err := d.PopBack()
+			if len(expected) == 0 {
+				if err == nil {
+					t.Fatalf("Deque.PopBack: Expected ErrEmptyDeque, got nil")
+				}
+				continue
+			}
+			if v != expected[len(expected)-1] {
+				t.Fatalf("Deque.PopBack: Expected %v, got %v", expected[len(expected)-1], v)
+			}
+			expected = expected[:len(expected)-1]
+		}
+
+		if d.Size() != int64(len(expected)) {
+			t.Fatalf("Deque.Size: Expected %v, got %v", len(expected), d.Size())
+		}
+	}
+
+	for i, v := range expected {
+		got, _ := d.At(i)
+		if got != v {
+			t.Fatalf("Deque.At: Expected %v, got %v", v, got)
+		}
+	}
+}
+
+func BenchmarkDeque_PushBackPopFront(b *testing.B) {
+	d := structures.NewDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		if d.Size() > 1024 {
+			d.PopFront()
+		}
+	}
+}
+
+func BenchmarkDeque_PushFrontPopBack(b *testing.B) {
+	d := structures.NewDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushFront(i)
+		if d.Size() > 1024 {
+			d.PopBack()
+		}
+	}
+}
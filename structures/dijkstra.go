@@ -0,0 +1,139 @@
+package structures
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ShortestPathTree holds the shortest paths from a source vertex to every vertex
+// reachable from it
+type ShortestPathTree[T comparable, W Numeric] struct {
+	// Source is the vertex the paths start from
+	Source T
+	// Distances maps every reachable vertex to the cost of its shortest path
+	Distances map[T]W
+	// Previous maps every reachable vertex but the source to its predecessor on the path
+	Previous map[T]T
+}
+
+// Reachable checks if there is a path from the source to the vertex
+func (t *ShortestPathTree[T, W]) Reachable(to T) bool {
+	_, reachable := t.Distances[to]
+	return reachable
+}
+
+// DistanceTo returns the cost of the shortest path from the source to the vertex
+// If the vertex is not reachable, retrieve an ErrFindingShortestPath
+func (t *ShortestPathTree[T, W]) DistanceTo(to T) (W, error) {
+	distance, reachable := t.Distances[to]
+	if !reachable {
+		return distance, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, t.Source, to)
+	}
+	return distance, nil
+}
+
+// PathTo returns the vertices of the shortest path from the source to the vertex
+// If the vertex is not reachable, retrieve an ErrFindingShortestPath
+func (t *ShortestPathTree[T, W]) PathTo(to T) ([]T, error) {
+	if !t.Reachable(to) {
+		return nil, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, t.Source, to)
+	}
+
+	path := []T{to}
+	for at, ok := t.Previous[to]; ok; at, ok = t.Previous[at] {
+		path = append(path, at)
+	}
+	slices.Reverse(path)
+
+	return path, nil
+}
+
+// negativeWeightTracker is implemented by the graphs that keep track of their negative
+// weights, so checking for them does not need to list every edge
+type negativeWeightTracker interface {
+	hasNegativeWeight() bool
+}
+
+// shortestPath finds the shortest path between two vertices of any graph and its cost
+// with Dijkstra's algorithm, stopping as soon as the target is reached
+func shortestPath[T comparable, W Numeric](g Graph[T, W], from, to T) ([]T, W, error) {
+	if _, err := g.Neighbors(to); err != nil {
+		return nil, 0, err
+	}
+
+	tree, err := shortestPathTree(g, from, &to)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	path, err := tree.PathTo(to)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return path, tree.Distances[to], nil
+}
+
+// shortestPathTree finds the shortest paths from a vertex of any graph with Dijkstra's
+// algorithm. When target is set, the search stops once the target is reached
+func shortestPathTree[T comparable, W Numeric](g Graph[T, W], from T, target *T) (*ShortestPathTree[T, W], error) {
+	if _, err := g.Neighbors(from); err != nil {
+		return nil, err
+	}
+
+	// Dijkstra's algorithm returns wrong paths when a weight is negative. The edges are
+	// only listed to find it when the graph cannot tell it has none.
+	if tracker, ok := g.(negativeWeightTracker); !ok || tracker.hasNegativeWeight() {
+		zero := NumericZeroValue[W]()
+		for _, edge := range g.Edges() {
+			if edge.Weight < zero {
+				return nil, fmt.Errorf("%w: %v -> %v, use BellmanFord", ErrNegativeWeight, edge.From, edge.To)
+			}
+		}
+	}
+
+	return dijkstra(g, from, func(_, _ T, w W) W { return w }, target), nil
+}
+
+// dijkstra finds the shortest paths from source to every reachable vertex, reading the
+// weight of every edge through weight, which must never return a negative value.
+// When target is set, the search stops once the target is reached
+func dijkstra[T comparable, W Numeric](g Graph[T, W], source T, weight func(from, to T, w W) W, target *T) *ShortestPathTree[T, W] {
+	tree := &ShortestPathTree[T, W]{
+		Source:    source,
+		Distances: map[T]W{source: NumericZeroValue[W]()},
+		Previous:  make(map[T]T),
+	}
+
+	// Vertices are queued once and their priority is lowered when a shorter
+	// distance is found, so the queue never orders them by stale distances.
+	pq := NewIndexedPriorityQueue[T, W](func(a, b W) bool {
+		return a < b
+	})
+	pq.Push(source, tree.Distances[source])
+
+	for pq.Size() > 0 {
+		current, distance, _ := pq.PopMin()
+		if target != nil && current == *target {
+			break
+		}
+
+		neighbors, _ := g.Neighbors(current)
+		for neighbor, w := range neighbors {
+			alt := distance + weight(current, neighbor, w)
+			if known, visited := tree.Distances[neighbor]; visited && alt >= known {
+				continue
+			}
+
+			tree.Distances[neighbor] = alt
+			tree.Previous[neighbor] = current
+			if pq.Contains(neighbor) {
+				pq.Update(neighbor, alt)
+			} else {
+				pq.Push(neighbor, alt)
+			}
+		}
+	}
+
+	return tree
+}
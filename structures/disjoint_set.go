@@ -0,0 +1,228 @@
+package structures
+
+import (
+	"errors"
+)
+
+var (
+	ErrDisjointSetElementNotFound = errors.New("element not found in disjoint set")
+	ErrInvalidSnapshot            = errors.New("invalid disjoint set snapshot")
+)
+
+// disjointSetChange is a change recorded to be undone by a rollback
+type disjointSetChange struct {
+	child  int  // root put under another root, -1 when an element was added
+	rankUp bool // whether the rank of the other root grew
+}
+
+// disjointSet represents a union-find over elements stored by index, merging sets by
+// rank and, unless changes are recorded, compressing paths on every Find
+type disjointSet[T comparable] struct {
+	index   map[T]int
+	values  []T
+	parent  []int
+	rank    []int
+	size    []int // number of elements of every set, kept on its root
+	count   int
+	record  bool
+	history []disjointSetChange
+}
+
+// NewDisjointSet creates a new empty disjoint set
+func NewDisjointSet[T comparable]() DisjointSetter[T] {
+	return &disjointSet[T]{
+		index: make(map[T]int),
+	}
+}
+
+// MakeSet adds the element in a set of its own, if it was not added yet
+func (ds *disjointSet[T]) MakeSet(value T) {
+	if _, exists := ds.index[value]; exists {
+		return
+	}
+
+	ds.index[value] = len(ds.parent)
+	ds.values = append(ds.values, value)
+	ds.parent = append(ds.parent, len(ds.parent))
+	ds.rank = append(ds.rank, 0)
+	ds.size = append(ds.size, 1)
+	ds.count++
+
+	if ds.record {
+		ds.history = append(ds.history, disjointSetChange{child: -1})
+	}
+}
+
+// Union merges the sets of both elements, reporting if they were in different sets
+// If any element was not added, retrieve an ErrDisjointSetElementNotFound
+func (ds *disjointSet[T]) Union(a, b T) (bool, error) {
+	i, j, err := ds.indexes(a, b)
+	if err != nil {
+		return false, err
+	}
+
+	rootA, rootB := ds.root(i), ds.root(j)
+	if rootA == rootB {
+		return false, nil
+	}
+
+	// the shorter tree goes under the taller one, so trees stay logarithmic
+	if ds.rank[rootA] < ds.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	ds.parent[rootB] = rootA
+	ds.size[rootA] += ds.size[rootB]
+	ds.count--
+
+	rankUp := ds.rank[rootA] == ds.rank[rootB]
+	if rankUp {
+		ds.rank[rootA]++
+	}
+
+	if ds.record {
+		ds.history = append(ds.history, disjointSetChange{child: rootB, rankUp: rankUp})
+	}
+
+	return true, nil
+}
+
+// Find returns the representative element of the set of the element
+// If the element was not added, retrieve an ErrDisjointSetElementNotFound
+func (ds *disjointSet[T]) Find(value T) (T, error) {
+	i, exists := ds.index[value]
+	if !exists {
+		var zero T
+		return zero, ErrDisjointSetElementNotFound
+	}
+
+	return ds.values[ds.root(i)], nil
+}
+
+// Connected checks if both elements are in the same set
+func (ds *disjointSet[T]) Connected(a, b T) bool {
+	i, j, err := ds.indexes(a, b)
+	return err == nil && ds.root(i) == ds.root(j)
+}
+
+// SetSize returns the number of elements in the set of the element
+// If the element was not added, retrieve an ErrDisjointSetElementNotFound
+func (ds *disjointSet[T]) SetSize(value T) (int, error) {
+	i, exists := ds.index[value]
+	if !exists {
+		return 0, ErrDisjointSetElementNotFound
+	}
+
+	return ds.size[ds.root(i)], nil
+}
+
+// Count returns the number of sets
+func (ds *disjointSet[T]) Count() int {
+	return ds.count
+}
+
+// Sets returns the elements of every set. Sets and their elements keep the order in
+// which the elements were added
+func (ds *disjointSet[T]) Sets() [][]T {
+	sets := make([][]T, 0, ds.count)
+	position := make(map[int]int, ds.count) // index in sets of every root
+
+	for i, value := range ds.values {
+		root := ds.root(i)
+		p, exists := position[root]
+		if !exists {
+			p = len(sets)
+			position[root] = p
+			sets = append(sets, make([]T, 0, ds.size[root]))
+		}
+		sets[p] = append(sets[p], value)
+	}
+
+	return sets
+}
+
+// Size returns the number of elements
+func (ds *disjointSet[T]) Size() int64 {
+	return int64(len(ds.values))
+}
+
+// root returns the root of the element at i, pointing every element on the way to it
+// unless changes are recorded, since a compressed path could not be undone
+func (ds *disjointSet[T]) root(i int) int {
+	root := i
+	for ds.parent[root] != root {
+		root = ds.parent[root]
+	}
+
+	if !ds.record {
+		for ds.parent[i] != root {
+			ds.parent[i], i = root, ds.parent[i]
+		}
+	}
+
+	return root
+}
+
+// indexes returns the positions of both elements
+func (ds *disjointSet[T]) indexes(a, b T) (int, int, error) {
+	i, aExists := ds.index[a]
+	j, bExists := ds.index[b]
+	if !aExists || !bExists {
+		return 0, 0, ErrDisjointSetElementNotFound
+	}
+	return i, j, nil
+}
+
+// rollbackDisjointSet represents a disjoint set that records its changes to undo them.
+// Paths are never compressed, so Find takes O(log n)
+type rollbackDisjointSet[T comparable] struct {
+	*disjointSet[T]
+}
+
+// NewRollbackDisjointSet creates a new empty disjoint set whose changes can be undone
+func NewRollbackDisjointSet[T comparable]() RollbackDisjointSetter[T] {
+	return &rollbackDisjointSet[T]{
+		disjointSet: &disjointSet[T]{
+			index:  make(map[T]int),
+			record: true,
+		},
+	}
+}
+
+// Snapshot returns the current state, to be restored by Rollback
+func (ds *rollbackDisjointSet[T]) Snapshot() int {
+	return len(ds.history)
+}
+
+// Rollback undoes every MakeSet and Union done after the snapshot was taken
+// If the snapshot is newer than the current state, retrieve an ErrInvalidSnapshot
+func (ds *rollbackDisjointSet[T]) Rollback(snapshot int) error {
+	if snapshot < 0 || snapshot > len(ds.history) {
+		return ErrInvalidSnapshot
+	}
+
+	for len(ds.history) > snapshot {
+		change := ds.history[len(ds.history)-1]
+		ds.history = ds.history[:len(ds.history)-1]
+
+		if change.child == -1 {
+			last := len(ds.values) - 1
+			delete(ds.index, ds.values[last])
+			ds.values = ds.values[:last]
+			ds.parent = ds.parent[:last]
+			ds.rank = ds.rank[:last]
+			ds.size = ds.size[:last]
+			ds.count--
+			continue
+		}
+
+		root := ds.parent[change.child]
+		ds.parent[change.child] = change.child
+		ds.size[root] -= ds.size[change.child]
+		if change.rankUp {
+			ds.rank[root]--
+		}
+		ds.count++
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package structures_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestDisjointSet_Union(t *testing.T) {
+	ds := structures.NewDisjointSet[string]()
+	for _, value := range []string{"a", "b", "c", "d"} {
+		ds.MakeSet(value)
+	}
+
+	if ds.Connected("a", "b") {
+		t.Fatalf("expected a and b to start in different sets")
+	}
+
+	if merged, err := ds.Union("a", "b"); !merged || err != nil {
+		t.Fatalf("expected a and b to be merged, got %v (error: %v)", merged, err)
+	}
+	if merged, _ := ds.Union("b", "a"); merged {
+		t.Fatalf("expected a and b to be already merged")
+	}
+
+	ds.Union("c", "d")
+	ds.Union("a", "d")
+
+	root, _ := ds.Find("a")
+	for _, value := range []string{"b", "c", "d"} {
+		if other, _ := ds.Find(value); other != root {
+			t.Fatalf("expected %v to have root %v, got %v", value, root, other)
+		}
+	}
+}
+
+func TestDisjointSet_MakeSet_Twice(t *testing.T) {
+	ds := structures.NewDisjointSet[int]()
+	ds.MakeSet(1)
+	ds.MakeSet(2)
+	ds.Union(1, 2)
+	ds.MakeSet(2)
+
+	if !ds.Connected(1, 2) {
+		t.Fatalf("expected MakeSet to keep an existing element in its set")
+	}
+}
+
+func TestDisjointSet_NotFound(t *testing.T) {
+	ds := structures.NewDisjointSet[int]()
+	ds.MakeSet(1)
+
+	if _, err := ds.Find(2); !errors.Is(err, structures.ErrDisjointSetElementNotFound) {
+		t.Fatalf("expected ErrDisjointSetElementNotFound, got %v", err)
+	}
+	if _, err := ds.Union(1, 2); !errors.Is(err, structures.ErrDisjointSetElementNotFound) {
+		t.Fatalf("expected ErrDisjointSetElementNotFound, got %v", err)
+	}
+	if ds.Connected(1, 2) {
+		t.Fatalf("expected missing element not to be connected")
+	}
+}
+
+func TestDisjointSet_LongChain(t *testing.T) {
+	ds := structures.NewDisjointSet[int]()
+	const n = 100000
+	for i := 0; i < n; i++ {
+		ds.MakeSet(i)
+		if i > 0 {
+			ds.Union(i-1, i)
+		}
+	}
+
+	if !ds.Connected(0, n-1) {
+		t.Fatalf("expected every element to be connected")
+	}
+}
+
+// disjointSetConstructors lists every disjoint set implementation
+var disjointSetConstructors = map[string]func() structures.DisjointSetter[string]{
+	"DisjointSet": structures.NewDisjointSet[string],
+	"RollbackDisjointSet": func() structures.DisjointSetter[string] {
+		return structures.NewRollbackDisjointSet[string]()
+	},
+}
+
+func TestDisjointSet_SetsAndSizes(t *testing.T) {
+	for name, newDisjointSet := range disjointSetConstructors {
+		t.Run(name, func(t *testing.T) {
+			ds := newDisjointSet()
+			for _, value := range []string{"a", "b", "c", "d", "e"} {
+				ds.MakeSet(value)
+			}
+			ds.Union("d", "b")
+			ds.Union("e", "a")
+			ds.Union("b", "e")
+
+			if ds.Count() != 2 || ds.Size() != 5 {
+				t.Fatalf("expected 2 sets of 5 elements, got %d sets of %d", ds.Count(), ds.Size())
+			}
+			if size, _ := ds.SetSize("e"); size != 4 {
+				t.Fatalf("expected set size 4, got %d", size)
+			}
+			if size, _ := ds.SetSize("c"); size != 1 {
+				t.Fatalf("expected set size 1, got %d", size)
+			}
+			if _, err := ds.SetSize("z"); !errors.Is(err, structures.ErrDisjointSetElementNotFound) {
+				t.Fatalf("expected ErrDisjointSetElementNotFound, got %v", err)
+			}
+
+			sets := ds.Sets()
+			if len(sets) != 2 || strings.Join(sets[0], "") != "abde" || strings.Join(sets[1], "") != "c" {
+				t.Fatalf("expected sets [[a b d e] [c]], got %v", sets)
+			}
+		})
+	}
+}
+
+func TestRollbackDisjointSet_Rollback(t *testing.T) {
+	ds := structures.NewRollbackDisjointSet[string]()
+	ds.MakeSet("a")
+	ds.MakeSet("b")
+	ds.Union("a", "b")
+
+	snapshot := ds.Snapshot()
+	ds.MakeSet("c")
+	ds.MakeSet("d")
+	ds.Union("c", "d")
+	ds.Union("a", "d")
+
+	if ds.Count() != 1 || !ds.Connected("b", "c") {
+		t.Fatalf("expected a single set before rollback, got %v", ds.Sets())
+	}
+
+	if err := ds.Rollback(snapshot); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if ds.Count() != 1 || ds.Size() != 2 || !ds.Connected("a", "b") {
+		t.Fatalf("expected set [a b] after rollback, got %v", ds.Sets())
+	}
+	if _, err := ds.Find("c"); !errors.Is(err, structures.ErrDisjointSetElementNotFound) {
+		t.Fatalf("expected c to be removed, got %v", err)
+	}
+	if size, _ := ds.SetSize("a"); size != 2 {
+		t.Fatalf("expected set size 2, got %d", size)
+	}
+
+	if err := ds.Rollback(snapshot + 1); !errors.Is(err, structures.ErrInvalidSnapshot) {
+		t.Fatalf("expected ErrInvalidSnapshot, got %v", err)
+	}
+	if err := ds.Rollback(0); err != nil || ds.Size() != 0 || ds.Count() != 0 {
+		t.Fatalf("expected an empty set, got %v (error: %v)", ds.Sets(), err)
+	}
+}
+
+func TestRollbackDisjointSet_RandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	ds := structures.NewRollbackDisjointSet[int]()
+	for i := 0; i < 50; i++ {
+		ds.MakeSet(i)
+	}
+
+	type state struct {
+		snapshot int
+		sets     string
+	}
+	var states []state
+
+	for step := 0; step < 2000; step++ {
+		switch n := rnd.Intn(10); {
+		case n < 6:
+			ds.Union(rnd.Intn(50), rnd.Intn(50))
+		case n < 8:
+			states = append(states, state{ds.Snapshot(), fmt.Sprint(ds.Sets())})
+		case len(states) > 0:
+			last := states[len(states)-1]
+			states = states[:len(states)-1]
+			ds.Rollback(last.snapshot)
+			if sets := fmt.Sprint(ds.Sets()); sets != last.sets {
+				t.Fatalf("step %d: expected %v after rollback, got %v", step, last.sets, sets)
+			}
+		}
+	}
+}
@@ -0,0 +1,532 @@
+package structures
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidDOT = errors.New("invalid DOT")
+)
+
+// DOTOptions configures how WriteDOT draws a graph. The zero value draws every vertex by
+// its value and every edge labeled with its weight
+type DOTOptions[T comparable, W Numeric] struct {
+	// Name is the name of the graph, omitted when empty
+	Name string
+	// VertexLabel formats the label of every vertex, which is identified by its value
+	VertexLabel func(vertex T) string
+	// EdgeLabel formats the label of every edge, an empty label is omitted. By default
+	// the label is the weight, which ReadDOT reads back
+	EdgeLabel func(edge Edge[T, W]) string
+	// Highlight is a path, such as a ShortestPath result, whose vertices and edges are
+	// drawn in HighlightColor
+	Highlight []T
+	// HighlightColor is the color of the highlighted path, red when empty
+	HighlightColor string
+}
+
+// WriteDOT writes the graph in the DOT language of Graphviz, as a digraph when it is
+// directed and as a graph otherwise. Vertices are identified by their value formatted
+// with %v, and both vertices and edges are sorted by it so the output is stable
+func WriteDOT[T comparable, W Numeric](w io.Writer, g Graph[T, W], opts DOTOptions[T, W]) error {
+	directed := g.IsDirected()
+	keyword, edgeOp := "graph", "--"
+	if directed {
+		keyword, edgeOp = "digraph", "->"
+	}
+
+	edgeLabel := opts.EdgeLabel
+	if edgeLabel == nil {
+		edgeLabel = func(edge Edge[T, W]) string {
+			return fmt.Sprint(edge.Weight)
+		}
+	}
+	color := opts.HighlightColor
+	if color == "" {
+		color = "red"
+	}
+
+	onPath := make(map[T]bool, len(opts.Highlight))
+	pathEdges := make(map[[2]T]bool, len(opts.Highlight))
+	for i, vertex := range opts.Highlight {
+		onPath[vertex] = true
+		if i > 0 {
+			previous := opts.Highlight[i-1]
+			pathEdges[[2]T{previous, vertex}] = true
+			if !directed {
+				pathEdges[[2]T{vertex, previous}] = true
+			}
+		}
+	}
+
+	vertices := slices.Clone(g.Vertices())
+	ids := make(map[T]string, len(vertices))
+	for _, vertex := range vertices {
+		ids[vertex] = fmt.Sprint(vertex)
+	}
+	slices.SortFunc(vertices, func(a, b T) int {
+		return strings.Compare(ids[a], ids[b])
+	})
+
+	edges := g.Edges()
+	for i, edge := range edges {
+		// undirected edges are written from the lower id, whichever way they are stored
+		if !directed && ids[edge.From] > ids[edge.To] {
+			edges[i].From, edges[i].To = edge.To, edge.From
+		}
+	}
+	slices.SortFunc(edges, func(a, b Edge[T, W]) int {
+		if c := strings.Compare(ids[a.From], ids[b.From]); c != 0 {
+			return c
+		}
+		return strings.Compare(ids[a.To], ids[b.To])
+	})
+
+	out := bufio.NewWriter(w)
+	if opts.Name != "" {
+		fmt.Fprintf(out, "%s %s {\n", keyword, dotQuote(opts.Name))
+	} else {
+		fmt.Fprintf(out, "%s {\n", keyword)
+	}
+
+	for _, vertex := range vertices {
+		var attributes [][2]string
+		if opts.VertexLabel != nil {
+			attributes = append(attributes, [2]string{"label", opts.VertexLabel(vertex)})
+		}
+		if onPath[vertex] {
+			attributes = append(attributes, [2]string{"color", color}, [2]string{"penwidth", "2"})
+		}
+		fmt.Fprintf(out, "\t%s%s;\n", dotQuote(ids[vertex]), dotAttributes(attributes))
+	}
+
+	for _, edge := range edges {
+		var attributes [][2]string
+		if label := edgeLabel(edge); label != "" {
+			attributes = append(attributes, [2]string{"label", label})
+		}
+		if pathEdges[[2]T{edge.From, edge.To}] {
+			attributes = append(attributes, [2]string{"color", color}, [2]string{"penwidth", "2"})
+		}
+		fmt.Fprintf(out, "\t%s %s %s%s;\n", dotQuote(ids[edge.From]), edgeOp, dotQuote(ids[edge.To]), dotAttributes(attributes))
+	}
+
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// dotQuote returns the text as a DOT quoted string
+func dotQuote(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(text) + `"`
+}
+
+// dotAttributes returns the attribute list of a statement, empty when there are none
+func dotAttributes(attributes [][2]string) string {
+	if len(attributes) == 0 {
+		return ""
+	}
+
+	parts := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		parts[i] = attribute[0] + "=" + dotQuote(attribute[1])
+	}
+	return " [" + strings.Join(parts, ", ") + "]"
+}
+
+// ReadDOT reads a graph written in a subset of the DOT language, creating it with
+// newGraph, as directed for a digraph, and its vertices by parsing their ids. The weight
+// of every edge is parsed from its weight attribute or, if missing, from its label; an
+// edge with neither gets the zero weight.
+//
+// The subset covers a single graph or digraph, optionally strict and named, whose body
+// holds node statements, edge statements, including chains such as a -> b -> c, and
+// attribute lists. Ids are identifiers, numerals or quoted strings. Graph, node and
+// edge default attributes and graph attributes such as rankdir=LR are accepted and
+// ignored, as are // and /* */ comments and lines starting with #. Subgraphs, ports,
+// HTML strings and string concatenation are not supported.
+// If the input is not in the subset, retrieve an ErrInvalidDOT
+func ReadDOT[T comparable, W Numeric](r io.Reader, newGraph func(directed bool) Graph[T, W], parseVertex func(id string) (T, error), parseWeight func(text string) (W, error)) (Graph[T, W], error) {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := dotTokens(string(input))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotParser[T, W]{
+		tokens:      tokens,
+		parseVertex: parseVertex,
+		parseWeight: parseWeight,
+		vertices:    make(map[string]T),
+	}
+	return p.parse(newGraph)
+}
+
+// dotTokenKind classifies the tokens of a DOT input
+type dotTokenKind int
+
+const (
+	dotID dotTokenKind = iota
+	dotPunct
+	dotEdgeOp
+	dotEOF
+)
+
+// dotToken is a token of a DOT input and the line where it starts
+type dotToken struct {
+	kind   dotTokenKind
+	text   string
+	quoted bool
+	line   int
+}
+
+// keyword checks if the token is the unquoted keyword, which DOT matches ignoring case
+func (t dotToken) keyword(keyword string) bool {
+	return t.kind == dotID && !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+// is checks if the token is the punctuation or edge operator
+func (t dotToken) is(text string) bool {
+	return (t.kind == dotPunct || t.kind == dotEdgeOp) && t.text == text
+}
+
+// dotError returns an ErrInvalidDOT describing the problem found on the line
+func dotError(line int, format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidDOT, line, fmt.Sprintf(format, args...))
+}
+
+// dotTokens splits a DOT input in tokens, skipping spaces and comments
+func dotTokens(input string) ([]dotToken, error) {
+	var tokens []dotToken
+	line := 1
+	lineStart := true // only spaces since the last line break
+
+	isIDByte := func(c byte) bool {
+		return c == '_' || c >= 0x80 || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+	}
+	isDigit := func(c byte) bool {
+		return '0' <= c && c <= '9'
+	}
+
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = true
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == '#' && lineStart:
+			for i < len(input) && input[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(input[i:], "//"):
+			for i < len(input) && input[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(input[i:], "/*"):
+			end := strings.Index(input[i+2:], "*/")
+			if end == -1 {
+				return nil, dotError(line, "unterminated comment")
+			}
+			line += strings.Count(input[i:i+2+end], "\n")
+			i += end + 4
+			continue
+		}
+
+		lineStart = false
+		start := line
+		switch {
+		case strings.ContainsRune("{}[]=;,", rune(c)):
+			tokens = append(tokens, dotToken{kind: dotPunct, text: string(c), line: line})
+			i++
+
+		case strings.HasPrefix(input[i:], "->") || strings.HasPrefix(input[i:], "--"):
+			tokens = append(tokens, dotToken{kind: dotEdgeOp, text: input[i : i+2], line: line})
+			i += 2
+
+		case c == '"':
+			var text strings.Builder
+			i++
+			for {
+				if i >= len(input) {
+					return nil, dotError(start, "unterminated string")
+				}
+				if input[i] == '"' {
+					i++
+					break
+				}
+				if input[i] == '\\' && i+1 < len(input) {
+					switch input[i+1] {
+					case '"', '\\':
+						text.WriteByte(input[i+1])
+					case 'n':
+						text.WriteByte('\n')
+					case '\n':
+						// an escaped line break continues the string
+						line++
+					default:
+						text.WriteString(input[i : i+2])
+					}
+					i += 2
+					continue
+				}
+				if input[i] == '\n' {
+					line++
+				}
+				text.WriteByte(input[i])
+				i++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, text: text.String(), quoted: true, line: start})
+
+		case c == '-' || c == '.' || isDigit(c):
+			j := i
+			if input[j] == '-' {
+				j++
+			}
+			digits := 0
+			for ; j < len(input) && isDigit(input[j]); j++ {
+				digits++
+			}
+			if j < len(input) && input[j] == '.' {
+				for j++; j < len(input) && isDigit(input[j]); j++ {
+					digits++
+				}
+			}
+			if digits == 0 {
+				return nil, dotError(line, "invalid numeral %q", input[i:j])
+			}
+			tokens = append(tokens, dotToken{kind: dotID, text: input[i:j], line: line})
+			i = j
+
+		case isIDByte(c):
+			j := i
+			for j < len(input) && isIDByte(input[j]) {
+				j++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, text: input[i:j], line: line})
+			i = j
+
+		case c == '<':
+			return nil, dotError(line, "HTML strings are not supported")
+		case c == ':':
+			return nil, dotError(line, "ports are not supported")
+		default:
+			return nil, dotError(line, "unexpected character %q", c)
+		}
+	}
+
+	return append(tokens, dotToken{kind: dotEOF, line: line}), nil
+}
+
+// dotParser builds a graph from the tokens of a DOT input
+type dotParser[T comparable, W Numeric] struct {
+	tokens      []dotToken
+	next        int
+	parseVertex func(id string) (T, error)
+	parseWeight func(text string) (W, error)
+	graph       Graph[T, W]
+	vertices    map[string]T // vertex of every id already added
+}
+
+// peek returns the next token without consuming it
+func (p *dotParser[T, W]) peek() dotToken {
+	return p.tokens[p.next]
+}
+
+// take consumes the next token, the last one is never consumed
+func (p *dotParser[T, W]) take() dotToken {
+	token := p.tokens[p.next]
+	if token.kind != dotEOF {
+		p.next++
+	}
+	return token
+}
+
+// expect consumes the next token, which must be the punctuation
+func (p *dotParser[T, W]) expect(text string) error {
+	if token := p.take(); !token.is(text) {
+		return dotError(token.line, "expected %q, got %q", text, token.text)
+	}
+	return nil
+}
+
+// parse reads the whole graph
+func (p *dotParser[T, W]) parse(newGraph func(directed bool) Graph[T, W]) (Graph[T, W], error) {
+	if p.peek().keyword("strict") {
+		p.take()
+	}
+
+	header := p.take()
+	switch {
+	case header.keyword("digraph"):
+		p.graph = newGraph(true)
+	case header.keyword("graph"):
+		p.graph = newGraph(false)
+	default:
+		return nil, dotError(header.line, "expected graph or digraph, got %q", header.text)
+	}
+
+	if p.peek().kind == dotID {
+		p.take()
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for !p.peek().is("}") {
+		if err := p.statement(); err != nil {
+			return nil, err
+		}
+	}
+	p.take()
+
+	if token := p.take(); token.kind != dotEOF {
+		return nil, dotError(token.line, "expected the end of the input, got %q", token.text)
+	}
+
+	return p.graph, nil
+}
+
+// statement reads a statement of the body of the graph
+func (p *dotParser[T, W]) statement() error {
+	token := p.take()
+	switch {
+	case token.is(";"):
+		return nil
+	case token.keyword("graph"), token.keyword("node"), token.keyword("edge"):
+		_, err := p.attributes()
+		return err
+	case token.keyword("subgraph"), token.is("{"):
+		return dotError(token.line, "subgraphs are not supported")
+	case token.kind == dotEOF:
+		return dotError(token.line, "expected \"}\" before the end of the input")
+	case token.kind != dotID:
+		return dotError(token.line, "expected a statement, got %q", token.text)
+	}
+
+	if p.peek().is("=") {
+		p.take()
+		if value := p.take(); value.kind != dotID {
+			return dotError(value.line, "expected a value for %q, got %q", token.text, value.text)
+		}
+		return nil
+	}
+
+	chain := []dotToken{token}
+	for p.peek().kind == dotEdgeOp {
+		op := p.take()
+		if (op.text == "->") != p.graph.IsDirected() {
+			return dotError(op.line, "edge operator %q does not match the kind of graph", op.text)
+		}
+
+		to := p.take()
+		if to.keyword("subgraph") || to.is("{") {
+			return dotError(to.line, "subgraphs are not supported")
+		}
+		if to.kind != dotID {
+			return dotError(to.line, "expected a vertex, got %q", to.text)
+		}
+		chain = append(chain, to)
+	}
+
+	attributes, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	if len(chain) == 1 {
+		_, err := p.vertex(token)
+		return err
+	}
+
+	var weight W
+	text, hasWeight := attributes["weight"]
+	if !hasWeight {
+		text, hasWeight = attributes["label"]
+	}
+	if hasWeight {
+		if weight, err = p.parseWeight(text); err != nil {
+			return dotError(token.line, "invalid weight %q: %v", text, err)
+		}
+	}
+
+	for i := 1; i < len(chain); i++ {
+		from, err := p.vertex(chain[i-1])
+		if err != nil {
+			return err
+		}
+		to, err := p.vertex(chain[i])
+		if err != nil {
+			return err
+		}
+		if err := p.graph.AddEdge(from, to, weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attributes reads the attribute lists following a statement, if any
+func (p *dotParser[T, W]) attributes() (map[string]string, error) {
+	attributes := make(map[string]string)
+
+	for p.peek().is("[") {
+		p.take()
+		for !p.peek().is("]") {
+			name := p.take()
+			if name.kind != dotID {
+				return nil, dotError(name.line, "expected an attribute, got %q", name.text)
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			value := p.take()
+			if value.kind != dotID {
+				return nil, dotError(value.line, "expected a value for %q, got %q", name.text, value.text)
+			}
+			attributes[name.text] = value.text
+
+			if p.peek().is(",") || p.peek().is(";") {
+				p.take()
+			}
+		}
+		p.take()
+	}
+
+	return attributes, nil
+}
+
+// vertex returns the vertex of the id, adding it to the graph the first time
+func (p *dotParser[T, W]) vertex(token dotToken) (T, error) {
+	if vertex, exists := p.vertices[token.text]; exists {
+		return vertex, nil
+	}
+
+	vertex, err := p.parseVertex(token.text)
+	if err != nil {
+		return vertex, dotError(token.line, "invalid vertex %q: %v", token.text, err)
+	}
+	// different ids, such as 1 and 01, may parse to the same vertex
+	if err := p.graph.AddVertex(vertex); err != nil && !errors.Is(err, ErrVertexAlreadyExists) {
+		return vertex, err
+	}
+
+	p.vertices[token.text] = vertex
+	return vertex, nil
+}
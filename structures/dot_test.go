@@ -0,0 +1,233 @@
+package structures_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// parseID returns the id itself as vertex
+func parseID(id string) (string, error) {
+	return id, nil
+}
+
+// readDOT reads a DOT input into an adjacency list graph of strings and integers
+func readDOT(input string) (structures.Graph[string, int], error) {
+	return structures.ReadDOT(strings.NewReader(input), structures.NewAdjacencyListGraph[string, int], parseID, strconv.Atoi)
+}
+
+func TestWriteDOT(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "c", "a", "b")
+		g.AddEdge("b", "c", 2)
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("a", "c", 5)
+
+		var out bytes.Buffer
+		if err := structures.WriteDOT(&out, g, structures.DOTOptions[string, int]{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expected := `digraph {
+	"a";
+	"b";
+	"c";
+	"a" -> "b" [label="1"];
+	"a" -> "c" [label="5"];
+	"b" -> "c" [label="2"];
+}
+`
+		if out.String() != expected {
+			t.Fatalf("expected\n%s\ngot\n%s", expected, out.String())
+		}
+	})
+}
+
+func TestWriteDOT_Undirected(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b")
+		g.AddEdge("b", "a", 3)
+
+		var out bytes.Buffer
+		structures.WriteDOT(&out, g, structures.DOTOptions[string, int]{Name: "G"})
+
+		expected := `graph "G" {
+	"a";
+	"b";
+	"a" -- "b" [label="3"];
+}
+`
+		if out.String() != expected {
+			t.Fatalf("expected\n%s\ngot\n%s", expected, out.String())
+		}
+	})
+}
+
+func TestWriteDOT_LabelsAndHighlight(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b", "c")
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("a", "c", 5)
+
+		path, _, err := g.ShortestPath("a", "c")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		var out bytes.Buffer
+		structures.WriteDOT(&out, g, structures.DOTOptions[string, int]{
+			VertexLabel: strings.ToUpper,
+			EdgeLabel: func(edge structures.Edge[string, int]) string {
+				if edge.Weight == 1 {
+					return ""
+				}
+				return fmt.Sprintf("cost %d", edge.Weight)
+			},
+			Highlight:      path,
+			HighlightColor: "blue",
+		})
+
+		expected := `digraph {
+	"a" [label="A", color="blue", penwidth="2"];
+	"b" [label="B", color="blue", penwidth="2"];
+	"c" [label="C", color="blue", penwidth="2"];
+	"a" -> "b" [color="blue", penwidth="2"];
+	"a" -> "c" [label="cost 5"];
+	"b" -> "c" [color="blue", penwidth="2"];
+}
+`
+		if out.String() != expected {
+			t.Fatalf("expected\n%s\ngot\n%s", expected, out.String())
+		}
+	})
+}
+
+func TestDOT_RoundTrip(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "a", `say "hi"`, `back\slash`, "line\nbreak", "alone", "-1")
+			g.AddEdge("a", `say "hi"`, -4)
+			g.AddEdge(`say "hi"`, `back\slash`, 7)
+			g.AddEdge(`back\slash`, "line\nbreak", 0)
+			g.AddEdge("line\nbreak", "a", 12)
+			g.AddEdge("-1", "-1", 3)
+
+			var out bytes.Buffer
+			if err := structures.WriteDOT(&out, g, structures.DOTOptions[string, int]{Name: "round trip"}); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			read, err := readDOT(out.String())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if read.IsDirected() != directed || len(read.Vertices()) != len(g.Vertices()) {
+				t.Fatalf("expected the same graph, got %v", read.Vertices())
+			}
+			if len(read.Edges()) != len(g.Edges()) {
+				t.Fatalf("expected edges %v, got %v", sortedEdges(g), sortedEdges(read))
+			}
+			for _, edge := range g.Edges() {
+				if weight, err := read.Weight(edge.From, edge.To); err != nil || weight != edge.Weight {
+					t.Fatalf("expected edge %v, got weight %v (error: %v)", edge, weight, err)
+				}
+			}
+		})
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	input := `
+# exported by hand
+strict digraph "deps" {
+	graph [rankdir=LR]
+	node [shape=box; color=gray]
+	edge [color=black]
+	label = "dependencies"
+
+	// a chain shares its attributes
+	app -> lib -> core [label=2]
+	app -> "core" [weight=9, label="ignored"];
+	/* a vertex
+	   without edges */
+	tools
+	lib -> util [color=red][label=-3]
+	util -> core
+}
+`
+	g, err := readDOT(input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !g.IsDirected() || len(g.Vertices()) != 5 {
+		t.Fatalf("expected a digraph of 5 vertices, got %v", g.Vertices())
+	}
+
+	expected := []structures.Edge[string, int]{
+		{From: "app", To: "core", Weight: 9},
+		{From: "app", To: "lib", Weight: 2},
+		{From: "lib", To: "core", Weight: 2},
+		{From: "lib", To: "util", Weight: -3},
+		{From: "util", To: "core", Weight: 0},
+	}
+	if edges := sortedEdges(g); !reflect.DeepEqual(edges, expected) {
+		t.Fatalf("expected edges %v, got %v", expected, edges)
+	}
+}
+
+func TestReadDOT_Vertices(t *testing.T) {
+	g, err := structures.ReadDOT(strings.NewReader("graph { 1 -- 2 -- 01 [label=0.5] }"),
+		structures.NewAdjacencyMatrixGraph[int, float64], strconv.Atoi, func(text string) (float64, error) {
+			return strconv.ParseFloat(text, 64)
+		})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if g.IsDirected() || len(g.Vertices()) != 2 {
+		t.Fatalf("expected an undirected graph of 2 vertices, got %v", g.Vertices())
+	}
+	if weight, err := g.Weight(2, 1); err != nil || weight != 0.5 {
+		t.Fatalf("expected weight 0.5, got %v (error: %v)", weight, err)
+	}
+}
+
+func TestReadDOT_Errors(t *testing.T) {
+	tests := map[string]string{
+		"missing header":     "{ a -> b }",
+		"edge operator":      "digraph { a -- b }",
+		"undirected":         "graph { a -> b }",
+		"subgraph":           "digraph { subgraph s { a } }",
+		"anonymous subgraph": "digraph { a -> { b c } }",
+		"port":               "digraph { a:n -> b }",
+		"html":               "digraph { a [label=<b>] }",
+		"unterminated":       `digraph { "a -> b }`,
+		"comment":            "digraph { /* a -> b }",
+		"missing brace":      "digraph { a -> b",
+		"trailing":           "digraph { } graph { }",
+		"weight":             "digraph { a -> b [label=heavy] }",
+		"attribute":          "digraph { a -> b [label] }",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := readDOT(input); !errors.Is(err, structures.ErrInvalidDOT) {
+				t.Fatalf("expected ErrInvalidDOT, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReadDOT_Line(t *testing.T) {
+	_, err := readDOT("digraph {\n\ta -> b\n\n\tb -- c\n}")
+	if err == nil || !strings.Contains(err.Error(), "line 4") {
+		t.Fatalf("expected an error on line 4, got %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+package structures
+
+// HopcroftKarpPhases returns the number of phases HopcroftKarp takes to match the graph
+func HopcroftKarpPhases[T comparable, W Numeric](g Graph[T, W], left, right []T) (int, error) {
+	b, err := newBipartiteGraph(g, left, right)
+	if err != nil {
+		return 0, err
+	}
+	_, phases := b.maximumMatching(len(right))
+	return phases, nil
+}
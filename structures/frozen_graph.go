@@ -0,0 +1,360 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrImmutableGraph = errors.New("graph is immutable")
+)
+
+// frozenGraph represents a read-only weighted graph in compressed sparse row form: the
+// vertices get dense ids in the order of the source graph, and the arcs leaving the
+// vertex i are targets[offsets[i]:offsets[i+1]], sorted by id, with their weights at the
+// same positions in weights. An undirected edge is stored as an arc in both directions
+type frozenGraph[T comparable, W Numeric] struct {
+	vertices []T
+	index    map[T]int
+	offsets  []int
+	targets  []int
+	weights  []W
+	directed bool
+	negative bool // whether a weight is negative, which Dijkstra's algorithm rejects
+}
+
+// NewFrozenGraph creates a read-only copy of the graph that uses less memory and finds
+// shortest paths faster, keeping its vertices in order, its directedness and its weights
+func NewFrozenGraph[T comparable, W Numeric](g Graph[T, W]) FrozenGraph[T, W] {
+	vertices := slices.Clone(g.Vertices())
+	frozen := &frozenGraph[T, W]{
+		vertices: vertices,
+		index:    make(map[T]int, len(vertices)),
+		offsets:  make([]int, len(vertices)+1),
+		directed: g.IsDirected(),
+	}
+	for i, vertex := range vertices {
+		frozen.index[vertex] = i
+	}
+
+	for i, vertex := range vertices {
+		neighbors, _ := g.Neighbors(vertex)
+		frozen.offsets[i+1] = frozen.offsets[i] + len(neighbors)
+	}
+	frozen.targets = make([]int, frozen.offsets[len(vertices)])
+	frozen.weights = make([]W, len(frozen.targets))
+
+	zero := NumericZeroValue[W]()
+	for i, vertex := range vertices {
+		neighbors, _ := g.Neighbors(vertex)
+		targets := frozen.targets[frozen.offsets[i]:frozen.offsets[i+1]]
+
+		k := 0
+		for neighbor := range neighbors {
+			targets[k] = frozen.index[neighbor]
+			k++
+		}
+		slices.Sort(targets)
+
+		for k, target := range targets {
+			weight := neighbors[vertices[target]]
+			frozen.weights[frozen.offsets[i]+k] = weight
+			frozen.negative = frozen.negative || weight < zero
+		}
+	}
+
+	return frozen
+}
+
+// AddVertex always fails with an ErrImmutableGraph.
+func (g *frozenGraph[T, W]) AddVertex(vertex T) error {
+	return ErrImmutableGraph
+}
+
+// RemoveVertex always fails with an ErrImmutableGraph.
+func (g *frozenGraph[T, W]) RemoveVertex(vertex T) error {
+	return ErrImmutableGraph
+}
+
+// AddEdge always fails with an ErrImmutableGraph.
+func (g *frozenGraph[T, W]) AddEdge(from, to T, weight W) error {
+	return ErrImmutableGraph
+}
+
+// RemoveEdge always fails with an ErrImmutableGraph.
+func (g *frozenGraph[T, W]) RemoveEdge(from, to T) error {
+	return ErrImmutableGraph
+}
+
+// HasEdge checks if there is an edge between two vertices.
+func (g *frozenGraph[T, W]) HasEdge(from, to T) bool {
+	_, err := g.Weight(from, to)
+	return err == nil
+}
+
+// Neighbors returns the neighbors of a vertex with their weights, in a new map.
+func (g *frozenGraph[T, W]) Neighbors(vertex T) (map[T]W, error) {
+	id, exists := g.index[vertex]
+	if !exists {
+		return nil, ErrVertexNotFound
+	}
+	neighbors := make(map[T]W, g.offsets[id+1]-g.offsets[id])
+	for arc := g.offsets[id]; arc < g.offsets[id+1]; arc++ {
+		neighbors[g.vertices[g.targets[arc]]] = g.weights[arc]
+	}
+	return neighbors, nil
+}
+
+// Weight returns the weight of the edge between two vertices, searching the arcs of
+// the source vertex in O(log degree).
+func (g *frozenGraph[T, W]) Weight(from, to T) (W, error) {
+	fromID, fromExists := g.index[from]
+	toID, toExists := g.index[to]
+	if !fromExists || !toExists {
+		return *new(W), ErrVertexNotFound
+	}
+	arcs := g.targets[g.offsets[fromID]:g.offsets[fromID+1]]
+	k, found := slices.BinarySearch(arcs, toID)
+	if !found {
+		return *new(W), ErrEdgeNotFound
+	}
+	return g.weights[g.offsets[fromID]+k], nil
+}
+
+// Vertices returns all vertices in the graph, ordered by id.
+func (g *frozenGraph[T, W]) Vertices() []T {
+	return slices.Clone(g.vertices)
+}
+
+// Edges returns all edges in the graph with their weights.
+// In an undirected graph every edge is reported once.
+func (g *frozenGraph[T, W]) Edges() []Edge[T, W] {
+	var edges []Edge[T, W]
+	for from := range g.vertices {
+		for arc := g.offsets[from]; arc < g.offsets[from+1]; arc++ {
+			to := g.targets[arc]
+			if !g.directed && to < from {
+				continue // Already reported from the other endpoint.
+			}
+			edges = append(edges, Edge[T, W]{From: g.vertices[from], To: g.vertices[to], Weight: g.weights[arc]})
+		}
+	}
+	return edges
+}
+
+// Degree returns the out-degree of a vertex.
+// In an undirected graph it is the number of incident edges, a self-loop counts once.
+func (g *frozenGraph[T, W]) Degree(vertex T) (int, error) {
+	id, exists := g.index[vertex]
+	if !exists {
+		return 0, ErrVertexNotFound
+	}
+	return g.offsets[id+1] - g.offsets[id], nil
+}
+
+// InDegree returns the in-degree of a vertex.
+// In an undirected graph it is the same as the degree.
+func (g *frozenGraph[T, W]) InDegree(vertex T) (int, error) {
+	id, exists := g.index[vertex]
+	if !exists {
+		return 0, ErrVertexNotFound
+	}
+	if !g.directed {
+		return g.Degree(vertex)
+	}
+	inDegree := 0
+	for _, target := range g.targets {
+		if target == id {
+			inDegree++
+		}
+	}
+	return inDegree, nil
+}
+
+// Transpose returns the transposed graph (reverses all edges), which is also frozen.
+// The transpose of an undirected graph is the graph itself, since it cannot change.
+func (g *frozenGraph[T, W]) Transpose() Graph[T, W] {
+	if !g.directed {
+		return g
+	}
+
+	transposed := &frozenGraph[T, W]{
+		vertices: g.vertices,
+		index:    g.index,
+		offsets:  make([]int, len(g.offsets)),
+		targets:  make([]int, len(g.targets)),
+		weights:  make([]W, len(g.weights)),
+		directed: true,
+		negative: g.negative,
+	}
+
+	// count the arcs entering every vertex, then place them in order of their source
+	for _, target := range g.targets {
+		transposed.offsets[target+1]++
+	}
+	for i := 1; i < len(transposed.offsets); i++ {
+		transposed.offsets[i] += transposed.offsets[i-1]
+	}
+	next := slices.Clone(transposed.offsets)
+	for from := range g.vertices {
+		for arc := g.offsets[from]; arc < g.offsets[from+1]; arc++ {
+			to := g.targets[arc]
+			transposed.targets[next[to]] = from
+			transposed.weights[next[to]] = g.weights[arc]
+			next[to]++
+		}
+	}
+
+	return transposed
+}
+
+// IsDirected returns whether the graph is directed or not.
+func (g *frozenGraph[T, W]) IsDirected() bool {
+	return g.directed
+}
+
+// ShortestPath implements Dijkstra's algorithm over the vertex ids to find the shortest
+// path and its cost.
+func (g *frozenGraph[T, W]) ShortestPath(from, to T) ([]T, W, error) {
+	source, target, err := g.endpoints(from, to)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distances, previous := g.dijkstra(source, target)
+	if previous[target] == -1 {
+		return nil, 0, fmt.Errorf("%w: no path from %v to %v", ErrFindingShortestPath, from, to)
+	}
+
+	path := []T{to}
+	for at := target; at != source; {
+		at = previous[at]
+		path = append(path, g.vertices[at])
+	}
+	slices.Reverse(path)
+
+	return path, distances[target], nil
+}
+
+// ShortestPathTree implements Dijkstra's algorithm over the vertex ids to find the
+// shortest paths from a vertex to every vertex, so they can be queried many times.
+func (g *frozenGraph[T, W]) ShortestPathTree(from T) (*ShortestPathTree[T, W], error) {
+	source, _, err := g.endpoints(from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	distances, previous := g.dijkstra(source, -1)
+	tree := &ShortestPathTree[T, W]{
+		Source:    from,
+		Distances: make(map[T]W),
+		Previous:  make(map[T]T),
+	}
+	for id, vertex := range g.vertices {
+		if previous[id] == -1 {
+			continue
+		}
+		tree.Distances[vertex] = distances[id]
+		if id != source {
+			tree.Previous[vertex] = g.vertices[previous[id]]
+		}
+	}
+
+	return tree, nil
+}
+
+// VertexID returns the dense id of a vertex, its position in Vertices.
+func (g *frozenGraph[T, W]) VertexID(vertex T) (int, error) {
+	id, exists := g.index[vertex]
+	if !exists {
+		return 0, ErrVertexNotFound
+	}
+	return id, nil
+}
+
+// VertexByID returns the vertex with the dense id.
+func (g *frozenGraph[T, W]) VertexByID(id int) (T, error) {
+	if id < 0 || id >= len(g.vertices) {
+		return *new(T), ErrVertexNotFound
+	}
+	return g.vertices[id], nil
+}
+
+// endpoints returns the ids of the endpoints of a shortest path search.
+// If a vertex is missing, retrieve an ErrVertexNotFound; if a weight is negative, an
+// ErrNegativeWeight
+func (g *frozenGraph[T, W]) endpoints(from, to T) (int, int, error) {
+	source, fromExists := g.index[from]
+	target, toExists := g.index[to]
+	if !fromExists || !toExists {
+		return 0, 0, ErrVertexNotFound
+	}
+
+	// Dijkstra's algorithm returns wrong paths when a weight is negative.
+	if g.negative {
+		for from := range g.vertices {
+			for arc := g.offsets[from]; arc < g.offsets[from+1]; arc++ {
+				if g.weights[arc] < 0 {
+					return 0, 0, fmt.Errorf("%w: %v -> %v, use BellmanFord", ErrNegativeWeight, g.vertices[from], g.vertices[g.targets[arc]])
+				}
+			}
+		}
+	}
+
+	return source, target, nil
+}
+
+// frozenQueueEntry is a vertex queued by Dijkstra's algorithm with the distance it was
+// reached with
+type frozenQueueEntry[W Numeric] struct {
+	vertex   int
+	distance W
+}
+
+// dijkstra finds the shortest distances from source to every reachable vertex, stopping
+// once target is reached unless it is -1. It returns the distance and predecessor of
+// every vertex by id, where the predecessor of unreached vertices is -1 and the one of
+// the source is itself
+func (g *frozenGraph[T, W]) dijkstra(source, target int) ([]W, []int) {
+	distances := make([]W, len(g.vertices))
+	previous := make([]int, len(g.vertices))
+	done := make([]bool, len(g.vertices))
+	for i := range previous {
+		previous[i] = -1
+	}
+	previous[source] = source
+
+	// Vertices are queued again when a shorter distance is found, and the stale
+	// entries are skipped, which is cheaper than updating them on a plain heap.
+	pq := NewHeapPriorityQueue(func(a, b frozenQueueEntry[W]) bool {
+		return a.distance < b.distance
+	})
+	pq.Push(frozenQueueEntry[W]{vertex: source})
+
+	for pq.Size() > 0 {
+		entry, _ := pq.Pop()
+		current := entry.vertex
+		if done[current] {
+			continue
+		}
+		done[current] = true
+		if current == target {
+			break
+		}
+
+		for arc := g.offsets[current]; arc < g.offsets[current+1]; arc++ {
+			neighbor := g.targets[arc]
+			alt := entry.distance + g.weights[arc]
+			if done[neighbor] || (previous[neighbor] != -1 && alt >= distances[neighbor]) {
+				continue
+			}
+
+			distances[neighbor] = alt
+			previous[neighbor] = current
+			pq.Push(frozenQueueEntry[W]{vertex: neighbor, distance: alt})
+		}
+	}
+
+	return distances, previous
+}
@@ -0,0 +1,271 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// randomGraph returns an adjacency list graph with the vertices 0 to vertices-1 and up
+// to edges random edges, with weights from 1 to 100
+func randomGraph(rnd *rand.Rand, directed bool, vertices, edges int) structures.Graph[int, int] {
+	g := structures.NewAdjacencyListGraph[int, int](directed)
+	for i := 0; i < vertices; i++ {
+		g.AddVertex(i)
+	}
+	for i := 0; i < edges; i++ {
+		g.AddEdge(rnd.Intn(vertices), rnd.Intn(vertices), rnd.Intn(100)+1)
+	}
+	return g
+}
+
+func TestFrozenGraph(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "c", "a", "d", "b")
+			g.AddEdge("c", "a", 3)
+			g.AddEdge("a", "b", 1)
+			g.AddEdge("d", "d", 7)
+			g.AddEdge("c", "b", 0)
+
+			frozen := structures.NewFrozenGraph(g)
+			checkSameGraph[string, int](t, g, frozen)
+			if vertices := frozen.Vertices(); !reflect.DeepEqual(vertices, []string{"c", "a", "d", "b"}) {
+				t.Fatalf("expected vertices in insertion order, got %v", vertices)
+			}
+
+			for _, vertex := range g.Vertices() {
+				expected, _ := g.Neighbors(vertex)
+				if neighbors, err := frozen.Neighbors(vertex); err != nil || !reflect.DeepEqual(neighbors, expected) {
+					t.Fatalf("expected neighbors of %v %v, got %v (error: %v)", vertex, expected, neighbors, err)
+				}
+				degree, _ := g.Degree(vertex)
+				inDegree, _ := g.InDegree(vertex)
+				if got, _ := frozen.Degree(vertex); got != degree {
+					t.Fatalf("expected degree of %v %d, got %d", vertex, degree, got)
+				}
+				if got, _ := frozen.InDegree(vertex); got != inDegree {
+					t.Fatalf("expected in-degree of %v %d, got %d", vertex, inDegree, got)
+				}
+			}
+			if frozen.HasEdge("b", "c") != g.HasEdge("b", "c") || !frozen.HasEdge("c", "b") {
+				t.Fatal("expected the edges of the original graph")
+			}
+			checkSameGraph(t, g.Transpose(), frozen.Transpose())
+
+			// changing the original graph does not change the frozen one
+			g.RemoveEdge("c", "a")
+			if !frozen.HasEdge("c", "a") {
+				t.Fatal("expected an independent copy")
+			}
+		})
+	}
+}
+
+func TestFrozenGraph_Immutable(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](true)
+	g.AddVertex("a")
+	g.AddVertex("b")
+	g.AddEdge("a", "b", 1)
+	frozen := structures.NewFrozenGraph(g)
+
+	for name, err := range map[string]error{
+		"AddVertex":    frozen.AddVertex("c"),
+		"RemoveVertex": frozen.RemoveVertex("a"),
+		"AddEdge":      frozen.AddEdge("b", "a", 1),
+		"RemoveEdge":   frozen.RemoveEdge("a", "b"),
+	} {
+		if !errors.Is(err, structures.ErrImmutableGraph) {
+			t.Fatalf("%s: expected ErrImmutableGraph, got %v", name, err)
+		}
+	}
+	checkSameGraph[string, int](t, g, frozen)
+	if err := frozen.Transpose().AddVertex("c"); !errors.Is(err, structures.ErrImmutableGraph) {
+		t.Fatalf("expected an immutable transpose, got %v", err)
+	}
+}
+
+func TestFrozenGraph_VertexID(t *testing.T) {
+	g := structures.NewAdjacencyMatrixGraph[string, int](false)
+	for _, vertex := range []string{"x", "y", "z"} {
+		g.AddVertex(vertex)
+	}
+	frozen := structures.NewFrozenGraph(g)
+
+	for i, vertex := range frozen.Vertices() {
+		if id, err := frozen.VertexID(vertex); err != nil || id != i {
+			t.Fatalf("expected id %d for %v, got %d (error: %v)", i, vertex, id, err)
+		}
+		if got, err := frozen.VertexByID(i); err != nil || got != vertex {
+			t.Fatalf("expected vertex %v for id %d, got %v (error: %v)", vertex, i, got, err)
+		}
+	}
+
+	if _, err := frozen.VertexID("w"); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+	for _, id := range []int{-1, 3} {
+		if _, err := frozen.VertexByID(id); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("id %d: expected ErrVertexNotFound, got %v", id, err)
+		}
+	}
+}
+
+func TestFrozenGraph_ShortestPath(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](true)
+	for _, vertex := range []string{"a", "b", "c", "d", "e"} {
+		g.AddVertex(vertex)
+	}
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("b", "d", 1)
+	frozen := structures.NewFrozenGraph(g)
+
+	path, cost, err := frozen.ShortestPath("a", "d")
+	if err != nil || cost != 4 || !reflect.DeepEqual(path, []string{"a", "c", "b", "d"}) {
+		t.Fatalf("expected path [a c b d] with cost 4, got %v with cost %d (error: %v)", path, cost, err)
+	}
+	if path, cost, err := frozen.ShortestPath("b", "b"); err != nil || cost != 0 || !reflect.DeepEqual(path, []string{"b"}) {
+		t.Fatalf("expected path [b] with cost 0, got %v with cost %d (error: %v)", path, cost, err)
+	}
+
+	if _, _, err := frozen.ShortestPath("a", "e"); !errors.Is(err, structures.ErrFindingShortestPath) {
+		t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+	}
+	if _, _, err := frozen.ShortestPath("a", "z"); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+	if _, err := frozen.ShortestPathTree("z"); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+
+	tree, err := frozen.ShortestPathTree("a")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tree.Reachable("e") || len(tree.Distances) != 4 {
+		t.Fatalf("expected the reachable vertices only, got %v", tree.Distances)
+	}
+	if path, _ := tree.PathTo("d"); !reflect.DeepEqual(path, []string{"a", "c", "b", "d"}) {
+		t.Fatalf("expected path [a c b d], got %v", path)
+	}
+
+	g.AddEdge("d", "e", -1)
+	negative := structures.NewFrozenGraph(g)
+	if _, _, err := negative.ShortestPath("a", "b"); !errors.Is(err, structures.ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight, got %v", err)
+	}
+	if _, err := negative.ShortestPathTree("a"); !errors.Is(err, structures.ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestFrozenGraph_ShortestPath_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		g := randomGraph(rnd, i%2 == 0, 30, 80)
+		frozen := structures.NewFrozenGraph(g)
+
+		from := rnd.Intn(30)
+		expected, err := g.ShortestPathTree(from)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		tree, err := frozen.ShortestPathTree(from)
+		if err != nil || !reflect.DeepEqual(tree.Distances, expected.Distances) {
+			t.Fatalf("expected distances %v, got %v (error: %v)", expected.Distances, tree.Distances, err)
+		}
+
+		for to := 0; to < 30; to++ {
+			path, cost, err := frozen.ShortestPath(from, to)
+			if !expected.Reachable(to) {
+				if !errors.Is(err, structures.ErrFindingShortestPath) {
+					t.Fatalf("%d -> %d: expected ErrFindingShortestPath, got %v", from, to, err)
+				}
+				continue
+			}
+			if err != nil || cost != expected.Distances[to] {
+				t.Fatalf("%d -> %d: expected cost %d, got %d (error: %v)", from, to, expected.Distances[to], cost, err)
+			}
+
+			// the path may differ on ties, but it must follow edges and add up to the cost
+			total := 0
+			for k := 1; k < len(path); k++ {
+				weight, err := g.Weight(path[k-1], path[k])
+				if err != nil {
+					t.Fatalf("%d -> %d: path %v follows a missing edge", from, to, path)
+				}
+				total += weight
+			}
+			if path[0] != from || path[len(path)-1] != to || total != cost {
+				t.Fatalf("%d -> %d: path %v does not add up to %d", from, to, path, cost)
+			}
+		}
+	}
+}
+
+// benchmarkShortestPath finds shortest paths between random vertices of a sparse graph
+// of 10000 vertices and 50000 edges, in the representation returned by convert
+func benchmarkShortestPath(b *testing.B, convert func(g structures.Graph[int, int]) structures.Graph[int, int]) {
+	rnd := rand.New(rand.NewSource(1))
+	g := convert(randomGraph(rnd, true, 10000, 50000))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.ShortestPath(rnd.Intn(10000), rnd.Intn(10000))
+	}
+}
+
+func BenchmarkAdjacencyListGraph_ShortestPath(b *testing.B) {
+	benchmarkShortestPath(b, func(g structures.Graph[int, int]) structures.Graph[int, int] {
+		return g
+	})
+}
+
+func BenchmarkFrozenGraph_ShortestPath(b *testing.B) {
+	benchmarkShortestPath(b, func(g structures.Graph[int, int]) structures.Graph[int, int] {
+		return structures.NewFrozenGraph(g)
+	})
+}
+
+// benchmarkGraphMemory builds a sparse graph with 8 edges per vertex in the
+// representation returned by build, reporting the heap it retains per edge
+func benchmarkGraphMemory(b *testing.B, build func(g structures.Graph[int, int]) structures.Graph[int, int]) {
+	for _, vertices := range []int{1000, 10000} {
+		b.Run(strconv.Itoa(vertices), func(b *testing.B) {
+			source := randomGraph(rand.New(rand.NewSource(1)), true, vertices, 8*vertices)
+			edges := len(source.Edges())
+
+			var before, after runtime.MemStats
+			var retained uint64
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+				g := build(source)
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				retained += after.HeapAlloc - before.HeapAlloc
+				runtime.KeepAlive(g)
+			}
+			b.ReportMetric(float64(retained)/float64(b.N)/float64(edges), "B/edge")
+		})
+	}
+}
+
+func BenchmarkAdjacencyListGraph_Memory(b *testing.B) {
+	benchmarkGraphMemory(b, structures.ToAdjacencyList[int, int])
+}
+
+func BenchmarkFrozenGraph_Memory(b *testing.B) {
+	benchmarkGraphMemory(b, func(g structures.Graph[int, int]) structures.Graph[int, int] {
+		return structures.NewFrozenGraph(g)
+	})
+}
@@ -0,0 +1,385 @@
+package structures_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// graphConstructors lists every Graph implementation checked by the conformance suite
+var graphConstructors = []struct {
+	name     string
+	newGraph func(directed bool) structures.Graph[string, int]
+}{
+	{"AdjacencyListGraph", structures.NewAdjacencyListGraph[string, int]},
+	{"AdjacencyMatrixGraph", structures.NewAdjacencyMatrixGraph[string, int]},
+}
+
+// forEachGraph runs the test against every Graph implementation
+func forEachGraph(t *testing.T, directed bool, test func(t *testing.T, g structures.Graph[string, int])) {
+	for _, constructor := range graphConstructors {
+		t.Run(constructor.name, func(t *testing.T) {
+			test(t, constructor.newGraph(directed))
+		})
+	}
+}
+
+// addVertices adds the vertices to the graph failing the test on error
+func addVertices(t *testing.T, g structures.Graph[string, int], vertices ...string) {
+	t.Helper()
+	for _, vertex := range vertices {
+		if err := g.AddVertex(vertex); err != nil {
+			t.Fatalf("AddVertex(%v): expected no error, got %v", vertex, err)
+		}
+	}
+}
+
+// sortedEdges returns the edges of the graph sorted by endpoints
+func sortedEdges(g structures.Graph[string, int]) []structures.Edge[string, int] {
+	edges := g.Edges()
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+func TestGraphConformance_Directed_AddEdge(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B")
+		g.AddEdge("A", "B", 3)
+
+		if !g.HasEdge("A", "B") || g.HasEdge("B", "A") {
+			t.Fatalf("expected only edge A -> B")
+		}
+
+		edges := g.Edges()
+		if len(edges) != 1 || edges[0] != (structures.Edge[string, int]{From: "A", To: "B", Weight: 3}) {
+			t.Fatalf("expected edge A -> B with weight 3, got %v", edges)
+		}
+	})
+}
+
+func TestGraphConformance_Directed_Degree(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("C", "A", 1)
+
+		expected := map[string][2]int{"A": {2, 1}, "B": {0, 1}, "C": {1, 1}}
+		for vertex, degrees := range expected {
+			degree, _ := g.Degree(vertex)
+			inDegree, _ := g.InDegree(vertex)
+			if degree != degrees[0] || inDegree != degrees[1] {
+				t.Fatalf("%v: expected degree %d and in-degree %d, got %d and %d", vertex, degrees[0], degrees[1], degree, inDegree)
+			}
+		}
+	})
+}
+
+func TestGraphConformance_Directed_Transpose(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 4)
+
+		transposed := g.Transpose()
+		if !transposed.HasEdge("B", "A") || transposed.HasEdge("A", "B") {
+			t.Fatalf("expected only edge B -> A in transposed graph")
+		}
+		if weight, _ := transposed.Weight("B", "A"); weight != 4 {
+			t.Fatalf("expected weight 4, got %d", weight)
+		}
+		if len(transposed.Vertices()) != 3 {
+			t.Fatalf("expected isolated vertices to be kept, got %v", transposed.Vertices())
+		}
+		if !transposed.IsDirected() {
+			t.Fatalf("expected transposed graph to be directed")
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_AddEdge(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B")
+		g.AddEdge("A", "B", 3)
+
+		if !g.HasEdge("A", "B") || !g.HasEdge("B", "A") {
+			t.Fatalf("expected edge in both directions")
+		}
+		if weight, err := g.Weight("B", "A"); err != nil || weight != 3 {
+			t.Fatalf("expected weight 3, got %d (error: %v)", weight, err)
+		}
+
+		neighbors, _ := g.Neighbors("B")
+		if len(neighbors) != 1 || neighbors["A"] != 3 {
+			t.Fatalf("expected neighbor A of B, got %v", neighbors)
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_AddEdge_UpdatesWeight(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B")
+		g.AddEdge("A", "B", 3)
+		g.AddEdge("B", "A", 5)
+
+		if weight, _ := g.Weight("A", "B"); weight != 5 {
+			t.Fatalf("expected weight 5, got %d", weight)
+		}
+		if len(g.Edges()) != 1 {
+			t.Fatalf("expected one edge, got %v", g.Edges())
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_RemoveEdge(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B")
+		g.AddEdge("A", "B", 3)
+
+		if err := g.RemoveEdge("B", "A"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if g.HasEdge("A", "B") || g.HasEdge("B", "A") {
+			t.Fatalf("expected edge to be removed in both directions")
+		}
+		if err := g.RemoveEdge("A", "B"); !errors.Is(err, structures.ErrEdgeNotFound) {
+			t.Fatalf("expected ErrEdgeNotFound, got %v", err)
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_Edges(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("C", "B", 2)
+		g.AddEdge("C", "C", 3)
+
+		edges := sortedEdges(g)
+		if len(edges) != 3 {
+			t.Fatalf("expected 3 edges, got %v", edges)
+		}
+
+		seen := make(map[[2]string]int)
+		for _, edge := range edges {
+			a, b := edge.From, edge.To
+			if b < a {
+				a, b = b, a
+			}
+			seen[[2]string{a, b}] = edge.Weight
+		}
+		expected := map[[2]string]int{{"A", "B"}: 1, {"B", "C"}: 2, {"C", "C"}: 3}
+		for pair, weight := range expected {
+			if seen[pair] != weight {
+				t.Fatalf("expected edge %v with weight %d, got %v", pair, weight, edges)
+			}
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_Degree(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("A", "C", 1)
+
+		expected := map[string]int{"A": 2, "B": 1, "C": 1}
+		for vertex, expectedDegree := range expected {
+			degree, _ := g.Degree(vertex)
+			inDegree, _ := g.InDegree(vertex)
+			if degree != expectedDegree || inDegree != expectedDegree {
+				t.Fatalf("%v: expected degree and in-degree %d, got %d and %d", vertex, expectedDegree, degree, inDegree)
+			}
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_Transpose(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 2)
+
+		transposed := g.Transpose()
+		if transposed.IsDirected() {
+			t.Fatalf("expected transposed graph to be undirected")
+		}
+
+		expected := sortedEdges(g)
+		got := sortedEdges(transposed)
+		if len(expected) != len(got) {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+		for _, edge := range expected {
+			if !transposed.HasEdge(edge.From, edge.To) || !transposed.HasEdge(edge.To, edge.From) {
+				t.Fatalf("expected edge %v in transposed graph", edge)
+			}
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_RemoveVertex(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+
+		if err := g.RemoveVertex("B"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(g.Edges()) != 0 {
+			t.Fatalf("expected no edges, got %v", g.Edges())
+		}
+		if degree, _ := g.Degree("A"); degree != 0 {
+			t.Fatalf("expected degree 0, got %d", degree)
+		}
+	})
+}
+
+func TestGraphConformance_Vertices_InsertionOrder(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "C", "A", "D", "B")
+		g.RemoveVertex("A")
+		addVertices(t, g, "A")
+
+		if vertices := g.Vertices(); !reflect.DeepEqual(vertices, []string{"C", "D", "B", "A"}) {
+			t.Fatalf("expected vertices in insertion order, got %v", vertices)
+		}
+	})
+}
+
+func TestGraphConformance_Undirected_ShortestPath(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+
+		path, cost, err := g.ShortestPath("C", "A")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cost != 2 {
+			t.Fatalf("expected cost 2, got %d", cost)
+		}
+		if len(path) != 3 || path[0] != "C" || path[1] != "B" || path[2] != "A" {
+			t.Fatalf("expected [C B A], got %v", path)
+		}
+	})
+}
+
+func TestGraphConformance_MissingVertex(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "A")
+
+			if err := g.AddEdge("A", "Z", 1); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("AddEdge: expected ErrVertexNotFound, got %v", err)
+			}
+			if err := g.RemoveVertex("Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("RemoveVertex: expected ErrVertexNotFound, got %v", err)
+			}
+			if _, err := g.Neighbors("Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("Neighbors: expected ErrVertexNotFound, got %v", err)
+			}
+			if _, err := g.Degree("Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("Degree: expected ErrVertexNotFound, got %v", err)
+			}
+			if _, err := g.InDegree("Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("InDegree: expected ErrVertexNotFound, got %v", err)
+			}
+			if _, _, err := g.ShortestPath("A", "Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+				t.Fatalf("ShortestPath: expected ErrVertexNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGraphConformance_ShortestPath_NegativeWeight(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "A", "B", "C", "D")
+			g.AddEdge("A", "B", 1)
+			g.AddEdge("C", "D", -1)
+
+			_, _, err := g.ShortestPath("A", "B")
+			if !errors.Is(err, structures.ErrNegativeWeight) || !strings.HasSuffix(err.Error(), ", use BellmanFord") {
+				t.Fatalf("expected ErrNegativeWeight suggesting BellmanFord, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGraphConformance_ShortestPath_NegativeWeightRemoved(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "A", "B", "C", "D")
+			g.AddEdge("A", "B", 1)
+			g.AddEdge("C", "C", -1)
+			g.AddEdge("C", "D", -1)
+			g.AddEdge("D", "A", -1)
+
+			g.RemoveEdge("C", "C")
+			g.AddEdge("C", "D", 2)
+			if _, _, err := g.ShortestPath("A", "B"); !errors.Is(err, structures.ErrNegativeWeight) {
+				t.Fatalf("expected ErrNegativeWeight, got %v", err)
+			}
+
+			g.RemoveVertex("D")
+			if _, cost, err := g.ShortestPath("A", "B"); err != nil || cost != 1 {
+				t.Fatalf("expected cost 1, got %d (error: %v)", cost, err)
+			}
+		})
+	}
+}
+
+func TestGraphConformance_ShortestPathTree(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C", "D", "E")
+		g.AddEdge("A", "B", 4)
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("C", "B", 2)
+		g.AddEdge("B", "D", 1)
+		g.AddEdge("E", "A", 1)
+
+		tree, err := g.ShortestPathTree("A")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expected := map[string]int{"A": 0, "B": 3, "C": 1, "D": 4}
+		for vertex, distance := range expected {
+			got, err := tree.DistanceTo(vertex)
+			if err != nil || got != distance {
+				t.Fatalf("%v: expected distance %d, got %d (error: %v)", vertex, distance, got, err)
+			}
+
+			path, _, _ := g.ShortestPath("A", vertex)
+			treePath, _ := tree.PathTo(vertex)
+			if strings.Join(path, " ") != strings.Join(treePath, " ") {
+				t.Fatalf("%v: expected path %v, got %v", vertex, path, treePath)
+			}
+		}
+
+		if tree.Reachable("E") || !tree.Reachable("D") {
+			t.Fatalf("expected D to be reachable and E not")
+		}
+		if _, err := tree.DistanceTo("E"); !errors.Is(err, structures.ErrFindingShortestPath) {
+			t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+		}
+		if _, err := tree.PathTo("E"); !errors.Is(err, structures.ErrFindingShortestPath) {
+			t.Fatalf("expected ErrFindingShortestPath, got %v", err)
+		}
+		if _, err := g.ShortestPathTree("Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("expected ErrVertexNotFound, got %v", err)
+		}
+	})
+}
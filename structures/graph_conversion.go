@@ -0,0 +1,96 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrDirectednessMismatch = errors.New("graphs differ in directedness")
+)
+
+// denseGraphDensity is the density from which Optimize prefers an adjacency matrix. A
+// matrix spends a pointer on every pair of vertices, while a list spends a map entry,
+// several times larger, on every edge only
+const denseGraphDensity = 0.25
+
+// CopyGraph adds the vertices of src to dst in the order of src.Vertices, skipping those
+// already in dst, and then every edge of src with its weight, replacing the weight of the
+// edges already in dst.
+// If the graphs differ in directedness, retrieve an ErrDirectednessMismatch
+func CopyGraph[T comparable, W Numeric](dst, src Graph[T, W]) error {
+	if dst.IsDirected() != src.IsDirected() {
+		return fmt.Errorf("%w: copying a graph with directed %v into one with directed %v", ErrDirectednessMismatch, src.IsDirected(), dst.IsDirected())
+	}
+
+	for _, vertex := range src.Vertices() {
+		if err := dst.AddVertex(vertex); err != nil && !errors.Is(err, ErrVertexAlreadyExists) {
+			return err
+		}
+	}
+	for _, edge := range src.Edges() {
+		if err := dst.AddEdge(edge.From, edge.To, edge.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToAdjacencyMatrix returns a copy of the graph represented with an adjacency matrix,
+// keeping its vertices in order, its directedness and its weights
+func ToAdjacencyMatrix[T comparable, W Numeric](g Graph[T, W]) Graph[T, W] {
+	matrix := NewAdjacencyMatrixGraph[T, W](g.IsDirected())
+	// CopyGraph cannot fail: the copy starts empty and has the same directedness
+	_ = CopyGraph(matrix, g)
+	return matrix
+}
+
+// ToAdjacencyList returns a copy of the graph represented with an adjacency list,
+// keeping its vertices in order, its directedness and its weights
+func ToAdjacencyList[T comparable, W Numeric](g Graph[T, W]) Graph[T, W] {
+	list := NewAdjacencyListGraph[T, W](g.IsDirected())
+	// CopyGraph cannot fail: the copy starts empty and has the same directedness
+	_ = CopyGraph(list, g)
+	return list
+}
+
+// Optimize returns the graph in the representation that suits its density, the share of
+// the possible edges it has: an adjacency matrix for dense graphs, with a density of at
+// least 0.25, and an adjacency list for sparse ones. The graph itself is returned when it
+// already has that representation, and a copy otherwise
+func Optimize[T comparable, W Numeric](g Graph[T, W]) Graph[T, W] {
+	_, isMatrix := g.(*adjacencyMatrixGraph[T, W])
+	_, isList := g.(*adjacencyListGraph[T, W])
+
+	if density(g) >= denseGraphDensity {
+		if isMatrix {
+			return g
+		}
+		return ToAdjacencyMatrix(g)
+	}
+
+	if isList {
+		return g
+	}
+	return ToAdjacencyList(g)
+}
+
+// density returns the number of edges of the graph over the number of possible edges,
+// counting self-loops, where every undirected edge stands for both directions
+func density[T comparable, W Numeric](g Graph[T, W]) float64 {
+	vertices := len(g.Vertices())
+	if vertices == 0 {
+		return 0
+	}
+
+	var arcs int
+	for _, edge := range g.Edges() {
+		arcs++
+		if !g.IsDirected() && edge.From != edge.To {
+			arcs++
+		}
+	}
+
+	return float64(arcs) / float64(vertices*vertices)
+}
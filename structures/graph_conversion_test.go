@@ -0,0 +1,149 @@
+package structures_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// representation returns the name of the type implementing the graph
+func representation(g structures.Graph[string, int]) string {
+	name := fmt.Sprintf("%T", g)
+	switch {
+	case strings.Contains(name, "adjacencyMatrixGraph"):
+		return "matrix"
+	case strings.Contains(name, "adjacencyListGraph"):
+		return "list"
+	default:
+		return name
+	}
+}
+
+// conversions lists every conversion between representations
+var conversions = map[string]func(g structures.Graph[string, int]) structures.Graph[string, int]{
+	"ToAdjacencyMatrix": structures.ToAdjacencyMatrix[string, int],
+	"ToAdjacencyList":   structures.ToAdjacencyList[string, int],
+}
+
+func TestGraphConversion(t *testing.T) {
+	for name, convert := range conversions {
+		for _, directed := range []bool{true, false} {
+			forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "c", "a", "d", "b")
+				g.AddEdge("c", "a", 3)
+				g.AddEdge("a", "b", -1)
+				g.AddEdge("d", "d", 7)
+				g.AddEdge("b", "c", 0)
+
+				converted := convert(g)
+				if expected := strings.ToLower(strings.TrimPrefix(name, "ToAdjacency")); representation(converted) != expected {
+					t.Fatalf("%s: expected an adjacency %s, got %s", name, expected, representation(converted))
+				}
+				if vertices := converted.Vertices(); !reflect.DeepEqual(vertices, []string{"c", "a", "d", "b"}) {
+					t.Fatalf("%s: expected vertices in insertion order, got %v", name, vertices)
+				}
+				checkSameGraph(t, g, converted)
+
+				// the copy does not share state with the original
+				converted.AddVertex("e")
+				if g.HasEdge("c", "a") != converted.HasEdge("c", "a") || len(g.Vertices()) != 4 {
+					t.Fatalf("%s: expected an independent copy", name)
+				}
+			})
+		}
+	}
+}
+
+func TestCopyGraph(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, src structures.Graph[string, int]) {
+		addVertices(t, src, "a", "b", "c")
+		src.AddEdge("a", "b", 1)
+		src.AddEdge("b", "c", 2)
+
+		dst := structures.NewAdjacencyListGraph[string, int](true)
+		dst.AddVertex("z")
+		dst.AddVertex("b")
+		dst.AddEdge("z", "b", 9)
+		dst.AddVertex("a")
+		dst.AddEdge("a", "b", 5)
+
+		if err := structures.CopyGraph(dst, src); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if vertices := dst.Vertices(); !reflect.DeepEqual(vertices, []string{"z", "b", "a", "c"}) {
+			t.Fatalf("expected the new vertices after the existing ones, got %v", vertices)
+		}
+
+		expected := []structures.Edge[string, int]{
+			{From: "a", To: "b", Weight: 1},
+			{From: "b", To: "c", Weight: 2},
+			{From: "z", To: "b", Weight: 9},
+		}
+		if edges := sortedEdges(dst); !reflect.DeepEqual(edges, expected) {
+			t.Fatalf("expected edges %v, got %v", expected, edges)
+		}
+	})
+}
+
+func TestCopyGraph_DirectednessMismatch(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, src structures.Graph[string, int]) {
+		addVertices(t, src, "a")
+		dst := structures.NewAdjacencyMatrixGraph[string, int](false)
+
+		if err := structures.CopyGraph(dst, src); !errors.Is(err, structures.ErrDirectednessMismatch) {
+			t.Fatalf("expected ErrDirectednessMismatch, got %v", err)
+		}
+		if len(dst.Vertices()) != 0 {
+			t.Fatalf("expected nothing copied, got %v", dst.Vertices())
+		}
+	})
+}
+
+func TestOptimize(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		forEachGraph(t, directed, func(t *testing.T, g structures.Graph[string, int]) {
+			vertices := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+			addVertices(t, g, vertices...)
+
+			// a path holds 7 of the 64 possible arcs, or 14 when undirected
+			for i := 1; i < len(vertices); i++ {
+				g.AddEdge(vertices[i-1], vertices[i], i)
+			}
+			sparse := structures.Optimize(g)
+			if representation(sparse) != "list" {
+				t.Fatalf("expected a sparse graph as adjacency list, got %s", representation(sparse))
+			}
+			checkSameGraph(t, g, sparse)
+			if representation(g) == "list" && sparse != g {
+				t.Fatal("expected the graph itself when it has the best representation")
+			}
+
+			// with every edge from the first half the graph holds at least 32 arcs
+			for _, from := range vertices[:4] {
+				for _, to := range vertices {
+					g.AddEdge(from, to, 1)
+				}
+			}
+			dense := structures.Optimize(g)
+			if representation(dense) != "matrix" {
+				t.Fatalf("expected a dense graph as adjacency matrix, got %s", representation(dense))
+			}
+			checkSameGraph(t, g, dense)
+			if representation(g) == "matrix" && dense != g {
+				t.Fatal("expected the graph itself when it has the best representation")
+			}
+		})
+	}
+}
+
+func TestOptimize_Empty(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		if optimized := structures.Optimize(g); representation(optimized) != "list" {
+			t.Fatalf("expected an empty graph as adjacency list, got %s", representation(optimized))
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package structures
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// graphSchemaVersion is the version of the schema written by the graph marshalers
+const graphSchemaVersion = 1
+
+var (
+	ErrGraphSchemaVersion = errors.New("unsupported graph schema version")
+	ErrGraphWeightType    = errors.New("graph weight type mismatch")
+	ErrInvalidGraphData   = errors.New("invalid graph data")
+)
+
+// graphSchema is the part of a serialized graph read first, to check it can be decoded
+type graphSchema struct {
+	Version    int    `json:"version"`
+	WeightType string `json:"weightType"`
+}
+
+// checkGraphSchema checks that a graph of the schema can be decoded with weights of type W.
+// If the schema has another version or weight type, retrieve an ErrGraphSchemaVersion
+// or an ErrGraphWeightType
+func checkGraphSchema[W Numeric](schema graphSchema) error {
+	if schema.Version != graphSchemaVersion {
+		return fmt.Errorf("%w: %d", ErrGraphSchemaVersion, schema.Version)
+	}
+	if expected := weightTypeName[W](); schema.WeightType != expected {
+		return fmt.Errorf("%w: expected %s, got %q", ErrGraphWeightType, expected, schema.WeightType)
+	}
+	return nil
+}
+
+// graphDocument is the schema of a graph serialized as JSON
+type graphDocument[T comparable, W Numeric] struct {
+	Version    int                   `json:"version"`
+	Directed   bool                  `json:"directed"`
+	WeightType string                `json:"weightType"`
+	Vertices   []T                   `json:"vertices"`
+	Edges      []graphJSONEdge[T, W] `json:"edges"`
+}
+
+// graphJSONEdge is an edge of a graph serialized as JSON, so the encoding of Edge itself
+// is left alone
+type graphJSONEdge[T comparable, W Numeric] struct {
+	From   T `json:"from"`
+	To     T `json:"to"`
+	Weight W `json:"weight"`
+}
+
+// graphBinaryEdge is an edge of a graph serialized as binary, whose endpoints are
+// positions in the vertices of the document
+type graphBinaryEdge[W Numeric] struct {
+	From, To int
+	Weight   W
+}
+
+// graphBinaryDocument is the schema of a graph serialized as binary with encoding/gob
+type graphBinaryDocument[T comparable, W Numeric] struct {
+	Version    int
+	Directed   bool
+	WeightType string
+	Vertices   []T
+	Edges      []graphBinaryEdge[W]
+}
+
+// weightTypeName returns the name of the kind of W, so named weight types share the
+// schema of their underlying type
+func weightTypeName[W Numeric]() string {
+	return reflect.TypeFor[W]().Kind().String()
+}
+
+// newGraphDocument returns the document of a graph whose vertices are listed in the given
+// order, listing its edges in the order of their endpoints. Undirected edges go from the
+// endpoint listed first
+func newGraphDocument[T comparable, W Numeric](g Graph[T, W], vertices []T) graphDocument[T, W] {
+	position := make(map[T]int, len(vertices))
+	for i, vertex := range vertices {
+		position[vertex] = i
+	}
+
+	edges := g.Edges()
+	for i, edge := range edges {
+		if !g.IsDirected() && position[edge.From] > position[edge.To] {
+			edges[i].From, edges[i].To = edge.To, edge.From
+		}
+	}
+	slices.SortFunc(edges, func(a, b Edge[T, W]) int {
+		if a.From != b.From {
+			return position[a.From] - position[b.From]
+		}
+		return position[a.To] - position[b.To]
+	})
+
+	document := graphDocument[T, W]{
+		Version:    graphSchemaVersion,
+		Directed:   g.IsDirected(),
+		WeightType: weightTypeName[W](),
+		Vertices:   vertices,
+		Edges:      make([]graphJSONEdge[T, W], len(edges)),
+	}
+	for i, edge := range edges {
+		document.Edges[i] = graphJSONEdge[T, W]{From: edge.From, To: edge.To, Weight: edge.Weight}
+	}
+	return document
+}
+
+// build creates a graph with newGraph holding the vertices and edges of the document.
+// If the document repeats a vertex or has an edge to an unknown vertex, retrieve an
+// ErrInvalidGraphData
+func (d *graphDocument[T, W]) build(newGraph func(directed bool) Graph[T, W]) (Graph[T, W], error) {
+	g := newGraph(d.Directed)
+	for _, vertex := range d.Vertices {
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidGraphData, err)
+		}
+	}
+	for _, edge := range d.Edges {
+		if err := g.AddEdge(edge.From, edge.To, edge.Weight); err != nil {
+			return nil, fmt.Errorf("%w: edge %v -> %v: %v", ErrInvalidGraphData, edge.From, edge.To, err)
+		}
+	}
+
+	return g, nil
+}
+
+// marshalGraphJSON encodes the document of a graph as JSON
+func marshalGraphJSON[T comparable, W Numeric](g Graph[T, W], vertices []T) ([]byte, error) {
+	return json.Marshal(newGraphDocument(g, vertices))
+}
+
+// unmarshalGraphJSON decodes a graph from JSON, creating it with newGraph
+func unmarshalGraphJSON[T comparable, W Numeric](data []byte, newGraph func(directed bool) Graph[T, W]) (Graph[T, W], error) {
+	var schema graphSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGraphData, err)
+	}
+	if err := checkGraphSchema[W](schema); err != nil {
+		return nil, err
+	}
+
+	var document graphDocument[T, W]
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGraphData, err)
+	}
+	return document.build(newGraph)
+}
+
+// marshalGraphBinary encodes the document of a graph with encoding/gob, storing the
+// endpoints of every edge as positions in the vertices
+func marshalGraphBinary[T comparable, W Numeric](g Graph[T, W], vertices []T) ([]byte, error) {
+	document := newGraphDocument(g, vertices)
+	position := make(map[T]int, len(vertices))
+	for i, vertex := range vertices {
+		position[vertex] = i
+	}
+
+	binary := graphBinaryDocument[T, W]{
+		Version:    document.Version,
+		Directed:   document.Directed,
+		WeightType: document.WeightType,
+		Vertices:   document.Vertices,
+		Edges:      make([]graphBinaryEdge[W], len(document.Edges)),
+	}
+	for i, edge := range document.Edges {
+		binary.Edges[i] = graphBinaryEdge[W]{From: position[edge.From], To: position[edge.To], Weight: edge.Weight}
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(binary); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// unmarshalGraphBinary decodes a graph encoded by marshalGraphBinary, creating it with
+// newGraph
+func unmarshalGraphBinary[T comparable, W Numeric](data []byte, newGraph func(directed bool) Graph[T, W]) (Graph[T, W], error) {
+	// gob skips the fields missing in the schema, so it is decoded on its own first
+	var schema graphSchema
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&schema); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGraphData, err)
+	}
+	if err := checkGraphSchema[W](schema); err != nil {
+		return nil, err
+	}
+
+	var binary graphBinaryDocument[T, W]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&binary); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGraphData, err)
+	}
+
+	document := graphDocument[T, W]{
+		Version:    binary.Version,
+		Directed:   binary.Directed,
+		WeightType: binary.WeightType,
+		Vertices:   binary.Vertices,
+		Edges:      make([]graphJSONEdge[T, W], len(binary.Edges)),
+	}
+	for i, edge := range binary.Edges {
+		if edge.From < 0 || edge.From >= len(binary.Vertices) || edge.To < 0 || edge.To >= len(binary.Vertices) {
+			return nil, fmt.Errorf("%w: edge %d -> %d out of range", ErrInvalidGraphData, edge.From, edge.To)
+		}
+		document.Edges[i] = graphJSONEdge[T, W]{From: binary.Vertices[edge.From], To: binary.Vertices[edge.To], Weight: edge.Weight}
+	}
+
+	return document.build(newGraph)
+}
@@ -0,0 +1,223 @@
+package structures_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// serializationCodecs lists every way a graph can be serialized
+var serializationCodecs = []struct {
+	name      string
+	marshal   func(v any) ([]byte, error)
+	unmarshal func(data []byte, v any) error
+}{
+	{"JSON", json.Marshal, json.Unmarshal},
+	{"Binary", func(v any) ([]byte, error) {
+		return v.(encoding.BinaryMarshaler).MarshalBinary()
+	}, func(data []byte, v any) error {
+		return v.(encoding.BinaryUnmarshaler).UnmarshalBinary(data)
+	}},
+}
+
+// checkSameGraph fails the test if both graphs do not have the same directedness,
+// vertices in the same order and edges
+func checkSameGraph[T comparable, W structures.Numeric](t *testing.T, expected, got structures.Graph[T, W]) {
+	t.Helper()
+
+	if got.IsDirected() != expected.IsDirected() {
+		t.Fatalf("expected directed %v, got %v", expected.IsDirected(), got.IsDirected())
+	}
+	if !reflect.DeepEqual(got.Vertices(), expected.Vertices()) || len(got.Edges()) != len(expected.Edges()) {
+		t.Fatalf("expected %v with edges %v, got %v with edges %v", expected.Vertices(), expected.Edges(), got.Vertices(), got.Edges())
+	}
+	for _, edge := range expected.Edges() {
+		if weight, err := got.Weight(edge.From, edge.To); err != nil || weight != edge.Weight {
+			t.Fatalf("expected edge %v, got weight %v (error: %v)", edge, weight, err)
+		}
+	}
+}
+
+// checkRoundTrip serializes a graph holding the weights with every codec, for every
+// representation and directedness, and decodes it into a graph of the other directedness
+func checkRoundTrip[W structures.Numeric](t *testing.T, weights ...W) {
+	t.Helper()
+
+	constructors := map[string]func(directed bool) structures.Graph[string, W]{
+		"AdjacencyListGraph":   structures.NewAdjacencyListGraph[string, W],
+		"AdjacencyMatrixGraph": structures.NewAdjacencyMatrixGraph[string, W],
+	}
+	vertices := []string{"c", "a", "e", "b", "d"} // not sorted, so the order must be kept
+
+	for name, newGraph := range constructors {
+		for _, directed := range []bool{true, false} {
+			for _, codec := range serializationCodecs {
+				g := newGraph(directed)
+				for _, vertex := range vertices {
+					g.AddVertex(vertex)
+				}
+				for i, weight := range weights {
+					g.AddEdge(vertices[i%len(vertices)], vertices[(i+1)%len(vertices)], weight)
+				}
+				g.AddEdge("e", "e", weights[0])
+
+				data, err := codec.marshal(g)
+				if err != nil {
+					t.Fatalf("%s %s: expected no error, got %v", name, codec.name, err)
+				}
+
+				decoded := newGraph(!directed)
+				decoded.AddVertex("stale")
+				if err := codec.unmarshal(data, decoded); err != nil {
+					t.Fatalf("%s %s: expected no error, got %v", name, codec.name, err)
+				}
+				checkSameGraph(t, g, decoded)
+			}
+		}
+	}
+}
+
+// latency is a named weight type, serialized like its underlying type
+type latency float32
+
+func TestGraphSerialization_WeightTypes(t *testing.T) {
+	t.Run("int", func(t *testing.T) { checkRoundTrip(t, math.MinInt, -1, 0, math.MaxInt) })
+	t.Run("int8", func(t *testing.T) { checkRoundTrip[int8](t, math.MinInt8, -1, 0, math.MaxInt8) })
+	t.Run("int16", func(t *testing.T) { checkRoundTrip[int16](t, math.MinInt16, -1, 0, math.MaxInt16) })
+	t.Run("int32", func(t *testing.T) { checkRoundTrip[int32](t, math.MinInt32, -1, 0, math.MaxInt32) })
+	t.Run("int64", func(t *testing.T) { checkRoundTrip[int64](t, math.MinInt64, -1, 0, math.MaxInt64) })
+	t.Run("uint", func(t *testing.T) { checkRoundTrip[uint](t, 0, 1, math.MaxUint) })
+	t.Run("uint8", func(t *testing.T) { checkRoundTrip[uint8](t, 0, 1, math.MaxUint8) })
+	t.Run("uint16", func(t *testing.T) { checkRoundTrip[uint16](t, 0, 1, math.MaxUint16) })
+	t.Run("uint32", func(t *testing.T) { checkRoundTrip[uint32](t, 0, 1, math.MaxUint32) })
+	t.Run("uint64", func(t *testing.T) { checkRoundTrip[uint64](t, 0, 1, math.MaxUint64) })
+	t.Run("uintptr", func(t *testing.T) { checkRoundTrip[uintptr](t, 0, 1, 1<<20) })
+	t.Run("float32", func(t *testing.T) {
+		checkRoundTrip[float32](t, -math.MaxFloat32, -0.1, 0, math.SmallestNonzeroFloat32, 1.0/3, math.MaxFloat32)
+	})
+	t.Run("float64", func(t *testing.T) {
+		checkRoundTrip(t, -math.MaxFloat64, -0.1, 0, math.SmallestNonzeroFloat64, 1.0/3, math.MaxFloat64)
+	})
+	t.Run("named", func(t *testing.T) { checkRoundTrip[latency](t, -2.5, 0, 0.1) })
+}
+
+func TestGraphSerialization_JSONSchema(t *testing.T) {
+	matrix := structures.NewAdjacencyMatrixGraph[string, int](true)
+	matrix.AddVertex("b")
+	matrix.AddVertex("a")
+	matrix.AddEdge("b", "a", 2)
+
+	data, err := json.Marshal(matrix)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := `{"version":1,"directed":true,"weightType":"int","vertices":["b","a"],"edges":[{"from":"b","to":"a","weight":2}]}`
+	if string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+
+	list := structures.NewAdjacencyListGraph[string, float64](false)
+	list.AddVertex("b")
+	list.AddVertex("c")
+	list.AddVertex("a")
+	list.AddEdge("c", "a", 0.5)
+
+	data, _ = json.Marshal(list)
+	expected = `{"version":1,"directed":false,"weightType":"float64","vertices":["b","c","a"],"edges":[{"from":"c","to":"a","weight":0.5}]}`
+	if string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestGraphSerialization_EdgeEncodingUnchanged(t *testing.T) {
+	data, err := json.Marshal(structures.Edge[string, int]{From: "a", To: "b", Weight: 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expected := `{"From":"a","To":"b","Weight":1}`; string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestGraphSerialization_StructVertices(t *testing.T) {
+	type point struct{ X, Y int }
+
+	for _, codec := range serializationCodecs {
+		g := structures.NewAdjacencyListGraph[point, float64](true)
+		g.AddVertex(point{0, 0})
+		g.AddVertex(point{3, 4})
+		g.AddEdge(point{0, 0}, point{3, 4}, 5)
+
+		data, err := codec.marshal(g)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", codec.name, err)
+		}
+		decoded := structures.NewAdjacencyMatrixGraph[point, float64](true)
+		if err := codec.unmarshal(data, decoded); err != nil {
+			t.Fatalf("%s: expected no error, got %v", codec.name, err)
+		}
+		checkSameGraph(t, g, decoded)
+	}
+}
+
+func TestGraphSerialization_WeightTypeMismatch(t *testing.T) {
+	for _, codec := range serializationCodecs {
+		forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+			addVertices(t, g, "a", "b")
+			g.AddEdge("a", "b", 1)
+			data, _ := codec.marshal(g)
+
+			decoded := structures.NewAdjacencyListGraph[string, float64](true)
+			decoded.AddVertex("kept")
+			if err := codec.unmarshal(data, decoded); !errors.Is(err, structures.ErrGraphWeightType) {
+				t.Fatalf("%s: expected ErrGraphWeightType, got %v", codec.name, err)
+			}
+			if vertices := decoded.Vertices(); len(vertices) != 1 || vertices[0] != "kept" {
+				t.Fatalf("%s: expected the graph to be unchanged, got %v", codec.name, vertices)
+			}
+		})
+	}
+}
+
+func TestGraphSerialization_InvalidJSON(t *testing.T) {
+	tests := map[string]struct {
+		data     string
+		expected error
+	}{
+		"version":        {`{"version":2,"directed":true,"weightType":"int"}`, structures.ErrGraphSchemaVersion},
+		"missing":        {`{}`, structures.ErrGraphSchemaVersion},
+		"malformed":      {`{"version":1,`, structures.ErrInvalidGraphData},
+		"weight":         {`{"version":1,"weightType":"int","vertices":["a"],"edges":[{"from":"a","to":"a","weight":0.5}]}`, structures.ErrInvalidGraphData},
+		"unknown vertex": {`{"version":1,"weightType":"int","vertices":["a"],"edges":[{"from":"a","to":"b","weight":1}]}`, structures.ErrInvalidGraphData},
+		"repeated":       {`{"version":1,"weightType":"int","vertices":["a","a"]}`, structures.ErrInvalidGraphData},
+	}
+
+	for name, test := range tests {
+		forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+			// json.Unmarshal rejects malformed input before reaching the graph
+			if err := g.(json.Unmarshaler).UnmarshalJSON([]byte(test.data)); !errors.Is(err, test.expected) {
+				t.Fatalf("%s: expected %v, got %v", name, test.expected, err)
+			}
+		})
+	}
+}
+
+func TestGraphSerialization_InvalidBinary(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "a", "b")
+		g.AddEdge("a", "b", 1)
+		data, _ := g.(encoding.BinaryMarshaler).MarshalBinary()
+
+		decoded := g.Transpose().(encoding.BinaryUnmarshaler)
+		for _, corrupted := range [][]byte{nil, []byte("graph"), data[:len(data)/2]} {
+			if err := decoded.UnmarshalBinary(corrupted); !errors.Is(err, structures.ErrInvalidGraphData) {
+				t.Fatalf("expected ErrInvalidGraphData, got %v", err)
+			}
+		}
+	})
+}
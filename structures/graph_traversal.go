@@ -0,0 +1,182 @@
+package structures
+
+import "slices"
+
+// VisitInfo describes a vertex reached by a graph traversal.
+// Discovery and Finish are timestamps taken from a single clock that ticks every
+// time a vertex is discovered or finished, Finish is zero until the vertex is finished.
+type VisitInfo[T comparable] struct {
+	Vertex    T
+	Parent    T
+	HasParent bool
+	Depth     int
+	Discovery int
+	Finish    int
+}
+
+// Visitor holds the optional hooks of a graph traversal.
+// PreOrder is called when a vertex is visited and PostOrder when all its neighbors
+// were explored, returning false from any of them stops the traversal.
+// Compare, when set, makes neighbors to be explored in ascending order, otherwise
+// the order is the one of Neighbors, which is not deterministic for every graph.
+type Visitor[T comparable] struct {
+	PreOrder  func(info VisitInfo[T]) bool
+	PostOrder func(info VisitInfo[T]) bool
+	Compare   func(a, b T) int
+}
+
+// preOrder calls the PreOrder hook if there is one
+func (v Visitor[T]) preOrder(info VisitInfo[T]) bool {
+	return v.PreOrder == nil || v.PreOrder(info)
+}
+
+// postOrder calls the PostOrder hook if there is one
+func (v Visitor[T]) postOrder(info VisitInfo[T]) bool {
+	return v.PostOrder == nil || v.PostOrder(info)
+}
+
+// BFS walks the graph in breadth-first order from start, visiting every reachable
+// vertex once. A vertex is discovered when it is queued and finished after its
+// neighbors are queued.
+func BFS[T comparable, W Numeric](g Graph[T, W], start T, visitor Visitor[T]) error {
+	if _, err := g.Neighbors(start); err != nil {
+		return err
+	}
+
+	clock := 1
+	infos := map[T]*VisitInfo[T]{start: {Vertex: start, Discovery: clock}}
+	queue := NewRingQueue[T](0)
+	queue.Push(start)
+
+	for queue.Size() > 0 {
+		current, _ := queue.Pop()
+		info := infos[current]
+
+		if !visitor.preOrder(*info) {
+			return nil
+		}
+
+		neighbors, err := orderedNeighbors(g, current, visitor.Compare)
+		if err != nil {
+			return err
+		}
+
+		for _, neighbor := range neighbors {
+			if _, discovered := infos[neighbor]; discovered {
+				continue
+			}
+
+			clock++
+			infos[neighbor] = &VisitInfo[T]{
+				Vertex:    neighbor,
+				Parent:    current,
+				HasParent: true,
+				Depth:     info.Depth + 1,
+				Discovery: clock,
+			}
+			queue.Push(neighbor)
+		}
+
+		clock++
+		info.Finish = clock
+		if !visitor.postOrder(*info) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// dfsFrame is a vertex on the depth-first search stack and its pending neighbors
+type dfsFrame[T comparable] struct {
+	info      *VisitInfo[T]
+	neighbors []T
+	next      int
+}
+
+// DFS walks the graph in depth-first order from start, visiting every reachable
+// vertex once. It uses an explicit stack, so deep graphs do not grow the call stack.
+func DFS[T comparable, W Numeric](g Graph[T, W], start T, visitor Visitor[T]) error {
+	if _, err := g.Neighbors(start); err != nil {
+		return err
+	}
+
+	clock := 0
+	discovered := make(map[T]bool)
+	var stack []*dfsFrame[T]
+
+	// discover marks the vertex, calls the PreOrder hook and pushes its frame
+	discover := func(info *VisitInfo[T]) (bool, error) {
+		clock++
+		info.Discovery = clock
+		discovered[info.Vertex] = true
+
+		if !visitor.preOrder(*info) {
+			return false, nil
+		}
+
+		neighbors, err := orderedNeighbors(g, info.Vertex, visitor.Compare)
+		if err != nil {
+			return false, err
+		}
+
+		stack = append(stack, &dfsFrame[T]{info: info, neighbors: neighbors})
+		return true, nil
+	}
+
+	if ok, err := discover(&VisitInfo[T]{Vertex: start}); !ok {
+		return err
+	}
+
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+
+		if frame.next < len(frame.neighbors) {
+			neighbor := frame.neighbors[frame.next]
+			frame.next++
+
+			if discovered[neighbor] {
+				continue
+			}
+
+			ok, err := discover(&VisitInfo[T]{
+				Vertex:    neighbor,
+				Parent:    frame.info.Vertex,
+				HasParent: true,
+				Depth:     frame.info.Depth + 1,
+			})
+			if !ok {
+				return err
+			}
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		clock++
+		frame.info.Finish = clock
+		if !visitor.postOrder(*frame.info) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// orderedNeighbors returns the neighbors of the vertex, sorted when compare is set
+func orderedNeighbors[T comparable, W Numeric](g Graph[T, W], vertex T, compare func(a, b T) int) ([]T, error) {
+	neighbors, err := g.Neighbors(vertex)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(neighbors))
+	for neighbor := range neighbors {
+		result = append(result, neighbor)
+	}
+
+	if compare != nil {
+		slices.SortFunc(result, compare)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,203 @@
+package structures_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// traversalGraph adds the edges A->B, A->C, B->D, C->D, D->E and the isolated vertex F
+func traversalGraph(t *testing.T, g structures.Graph[string, int]) {
+	t.Helper()
+	addVertices(t, g, "A", "B", "C", "D", "E", "F")
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("A", "C", 1)
+	g.AddEdge("B", "D", 1)
+	g.AddEdge("C", "D", 1)
+	g.AddEdge("D", "E", 1)
+}
+
+// recordVisits returns a visitor with sorted neighbors that records every visit
+func recordVisits(pre, post *[]structures.VisitInfo[string]) structures.Visitor[string] {
+	return structures.Visitor[string]{
+		PreOrder: func(info structures.VisitInfo[string]) bool {
+			*pre = append(*pre, info)
+			return true
+		},
+		PostOrder: func(info structures.VisitInfo[string]) bool {
+			*post = append(*post, info)
+			return true
+		},
+		Compare: strings.Compare,
+	}
+}
+
+func visitedVertices(infos []structures.VisitInfo[string]) string {
+	var sb strings.Builder
+	for _, info := range infos {
+		sb.WriteString(info.Vertex)
+	}
+	return sb.String()
+}
+
+func TestBFS(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		traversalGraph(t, g)
+
+		var pre, post []structures.VisitInfo[string]
+		if err := structures.BFS(g, "A", recordVisits(&pre, &post)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if order := visitedVertices(pre); order != "ABCDE" {
+			t.Fatalf("expected pre-order ABCDE, got %v", order)
+		}
+		if order := visitedVertices(post); order != "ABCDE" {
+			t.Fatalf("expected post-order ABCDE, got %v", order)
+		}
+
+		expectedDepths := map[string]int{"A": 0, "B": 1, "C": 1, "D": 2, "E": 3}
+		for _, info := range post {
+			if info.Depth != expectedDepths[info.Vertex] {
+				t.Fatalf("%v: expected depth %d, got %d", info.Vertex, expectedDepths[info.Vertex], info.Depth)
+			}
+			if info.Finish <= info.Discovery {
+				t.Fatalf("%v: expected finish after discovery, got %d and %d", info.Vertex, info.Discovery, info.Finish)
+			}
+		}
+		if post[3].Parent != "B" || !post[3].HasParent || post[0].HasParent {
+			t.Fatalf("expected D to be reached from B and A to be the root, got %v", post)
+		}
+	})
+}
+
+func TestDFS(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		traversalGraph(t, g)
+
+		var pre, post []structures.VisitInfo[string]
+		if err := structures.DFS(g, "A", recordVisits(&pre, &post)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if order := visitedVertices(pre); order != "ABDEC" {
+			t.Fatalf("expected pre-order ABDEC, got %v", order)
+		}
+		if order := visitedVertices(post); order != "EDBCA" {
+			t.Fatalf("expected post-order EDBCA, got %v", order)
+		}
+
+		expected := map[string][3]int{
+			"A": {0, 1, 10},
+			"B": {1, 2, 7},
+			"D": {2, 3, 6},
+			"E": {3, 4, 5},
+			"C": {1, 8, 9},
+		}
+		for _, info := range post {
+			values := expected[info.Vertex]
+			if info.Depth != values[0] || info.Discovery != values[1] || info.Finish != values[2] {
+				t.Fatalf("%v: expected depth %d and times %d/%d, got %d and %d/%d",
+					info.Vertex, values[0], values[1], values[2], info.Depth, info.Discovery, info.Finish)
+			}
+		}
+	})
+}
+
+func TestTraversal_EarlyTermination(t *testing.T) {
+	traversals := map[string]func(structures.Graph[string, int], string, structures.Visitor[string]) error{
+		"BFS": structures.BFS[string, int],
+		"DFS": structures.DFS[string, int],
+	}
+
+	for name, traverse := range traversals {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				traversalGraph(t, g)
+
+				visited := 0
+				err := traverse(g, "A", structures.Visitor[string]{
+					PreOrder: func(info structures.VisitInfo[string]) bool {
+						visited++
+						return info.Vertex != "B"
+					},
+					Compare: strings.Compare,
+				})
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if visited != 2 {
+					t.Fatalf("expected traversal to stop after 2 visits, got %d", visited)
+				}
+
+				finished := 0
+				traverse(g, "A", structures.Visitor[string]{
+					PostOrder: func(info structures.VisitInfo[string]) bool {
+						finished++
+						return false
+					},
+				})
+				if finished != 1 {
+					t.Fatalf("expected traversal to stop after 1 finish, got %d", finished)
+				}
+			})
+		})
+	}
+}
+
+func TestTraversal_Undirected(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("B", "A", 1)
+		g.AddEdge("C", "B", 1)
+
+		var pre, post []structures.VisitInfo[string]
+		structures.DFS(g, "C", recordVisits(&pre, &post))
+		if order := visitedVertices(pre); order != "CBA" {
+			t.Fatalf("expected DFS pre-order CBA, got %v", order)
+		}
+
+		pre, post = nil, nil
+		structures.BFS(g, "A", recordVisits(&pre, &post))
+		if order := visitedVertices(pre); order != "ABC" {
+			t.Fatalf("expected BFS pre-order ABC, got %v", order)
+		}
+	})
+}
+
+func TestTraversal_MissingStart(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A")
+
+		if err := structures.BFS(g, "Z", structures.Visitor[string]{}); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("BFS: expected ErrVertexNotFound, got %v", err)
+		}
+		if err := structures.DFS(g, "Z", structures.Visitor[string]{}); !errors.Is(err, structures.ErrVertexNotFound) {
+			t.Fatalf("DFS: expected ErrVertexNotFound, got %v", err)
+		}
+	})
+}
+
+func TestDFS_DeepGraph(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[int, int](true)
+	const n = 100000
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+		if i > 0 {
+			g.AddEdge(i-1, i, 1)
+		}
+	}
+
+	maxDepth := 0
+	structures.DFS(g, 0, structures.Visitor[int]{
+		PreOrder: func(info structures.VisitInfo[int]) bool {
+			maxDepth = info.Depth
+			return true
+		},
+	})
+	if maxDepth != n-1 {
+		t.Fatalf("expected depth %d, got %d", n-1, maxDepth)
+	}
+}
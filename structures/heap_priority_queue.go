@@ -0,0 +1,207 @@
+package structures
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// HeapPriorityQueueOption configures a heap priority queue
+type HeapPriorityQueueOption func(*heapPriorityQueueConfig)
+
+// heapPriorityQueueConfig stores the policies used by the heap priority queue
+type heapPriorityQueueConfig struct {
+	stable bool
+}
+
+// WithStableOrder makes elements with the same priority leave the queue in the
+// same order they were pushed
+func WithStableOrder() HeapPriorityQueueOption {
+	return func(c *heapPriorityQueueConfig) {
+		c.stable = true
+	}
+}
+
+// heapEntry is an element of the heap and the order it was pushed
+type heapEntry[T comparable] struct {
+	value    T
+	sequence uint64
+}
+
+// heapPriorityQueue represents a priority queue stored on a slice-backed binary heap,
+// where every element is lower or equal than its children
+type heapPriorityQueue[T comparable] struct {
+	entries  []heapEntry[T]
+	less     func(a, b T) bool
+	stable   bool
+	sequence uint64
+}
+
+// NewHeapPriorityQueue creates a new priority queue where Pop retrieves the lowest
+// element according to less. Elements that compare equal are all kept
+func NewHeapPriorityQueue[T comparable](less func(a, b T) bool, opts ...HeapPriorityQueueOption) HeapPriorityQueuer[T] {
+	var config heapPriorityQueueConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &heapPriorityQueue[T]{
+		less:   less,
+		stable: config.stable,
+	}
+}
+
+// Push adds an element to the priority queue
+func (pq *heapPriorityQueue[T]) Push(value T) {
+	pq.entries = append(pq.entries, pq.newEntry(value))
+	pq.siftUp(len(pq.entries) - 1)
+}
+
+// PushMany adds several elements to the priority queue. When they are many compared
+// to the elements already queued, the heap is rebuilt in linear time
+func (pq *heapPriorityQueue[T]) PushMany(values ...T) {
+	previous := len(pq.entries)
+	for _, value := range values {
+		pq.entries = append(pq.entries, pq.newEntry(value))
+	}
+
+	pq.restore(previous)
+}
+
+// Merge moves all the elements of other into the priority queue, leaving other empty
+func (pq *heapPriorityQueue[T]) Merge(other HeapPriorityQueuer[T]) {
+	if other == nil || other == HeapPriorityQueuer[T](pq) {
+		return
+	}
+
+	otherHeap, ok := other.(*heapPriorityQueue[T])
+	if !ok {
+		var values []T
+		for other.Size() > 0 {
+			value, _ := other.Pop()
+			values = append(values, value)
+		}
+		pq.PushMany(values...)
+		return
+	}
+
+	entries := otherHeap.entries
+	otherHeap.entries = nil
+
+	// keep the push order of the other queue after the elements already queued
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].sequence < entries[j].sequence
+	})
+
+	previous := len(pq.entries)
+	for _, entry := range entries {
+		pq.entries = append(pq.entries, pq.newEntry(entry.value))
+	}
+
+	pq.restore(previous)
+}
+
+// Pop removes and returns the element with the highest priority
+func (pq *heapPriorityQueue[T]) Pop() (T, error) {
+	if len(pq.entries) == 0 {
+		var zero T
+		return zero, ErrPriorityQueueEmpty
+	}
+
+	last := len(pq.entries) - 1
+	value := pq.entries[0].value
+	pq.entries[0] = pq.entries[last]
+	pq.entries[last] = heapEntry[T]{} // let the garbage collector reclaim the value
+	pq.entries = pq.entries[:last]
+
+	if len(pq.entries) > 0 {
+		pq.siftDown(0)
+	}
+
+	return value, nil
+}
+
+// Top returns the element with the highest priority without removing it
+func (pq *heapPriorityQueue[T]) Top() (T, error) {
+	if len(pq.entries) == 0 {
+		var zero T
+		return zero, ErrPriorityQueueEmpty
+	}
+
+	return pq.entries[0].value, nil
+}
+
+// Size returns the number of elements in the priority queue
+func (pq *heapPriorityQueue[T]) Size() int64 {
+	return int64(len(pq.entries))
+}
+
+// newEntry wraps the value with the next push sequence
+func (pq *heapPriorityQueue[T]) newEntry(value T) heapEntry[T] {
+	pq.sequence++
+	return heapEntry[T]{value: value, sequence: pq.sequence}
+}
+
+// restore brings back the heap property after appending entries from index previous.
+// Rebuilding costs O(n) while sifting up each entry costs O(k log n), so the cheapest is used
+func (pq *heapPriorityQueue[T]) restore(previous int) {
+	added := len(pq.entries) - previous
+	if added*bits.Len(uint(len(pq.entries))) > len(pq.entries) {
+		for i := len(pq.entries)/2 - 1; i >= 0; i-- {
+			pq.siftDown(i)
+		}
+		return
+	}
+
+	for i := previous; i < len(pq.entries); i++ {
+		pq.siftUp(i)
+	}
+}
+
+// before checks if the entry at i must leave the queue before the entry at j
+func (pq *heapPriorityQueue[T]) before(i, j int) bool {
+	a, b := pq.entries[i], pq.entries[j]
+	if pq.less(a.value, b.value) {
+		return true
+	}
+
+	if pq.stable && !pq.less(b.value, a.value) {
+		return a.sequence < b.sequence
+	}
+
+	return false
+}
+
+// siftUp moves the entry at i up until its parent goes before it
+func (pq *heapPriorityQueue[T]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.before(i, parent) {
+			return
+		}
+
+		pq.entries[i], pq.entries[parent] = pq.entries[parent], pq.entries[i]
+		i = parent
+	}
+}
+
+// siftDown moves the entry at i down until it goes before its children
+func (pq *heapPriorityQueue[T]) siftDown(i int) {
+	n := len(pq.entries)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && pq.before(left, smallest) {
+			smallest = left
+		}
+		if right < n && pq.before(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.entries[i], pq.entries[smallest] = pq.entries[smallest], pq.entries[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,260 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func lessInts(a, b int) bool {
+	return a < b
+}
+
+type heapTask struct {
+	priority int
+	name     string
+}
+
+func lessHeapTasks(a, b heapTask) bool {
+	return a.priority < b.priority
+}
+
+func TestNewHeapPriorityQueue(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	if pq == nil {
+		t.Fatal("NewHeapPriorityQueue should not return nil")
+	}
+
+	if pq.Size() != 0 {
+		t.Fatalf("expected size to be 0 but got %d", pq.Size())
+	}
+}
+
+func TestHeapPriorityQueue_Pop_Lowest(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	for _, num := range []int{5, 3, 7, 3, 1, 4, 6, 8} {
+		pq.Push(num)
+	}
+
+	for _, num := range []int{1, 3, 3, 4, 5, 6, 7, 8} {
+		top, err := pq.Pop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if top != num {
+			t.Fatalf("expected %d but got %d", num, top)
+		}
+	}
+}
+
+func TestHeapPriorityQueue_Pop_Highest(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](func(a, b int) bool { return a > b })
+	for _, num := range []int{5, 3, 7, 1, 1, 4, 6, 8} {
+		pq.Push(num)
+	}
+
+	for _, num := range []int{8, 7, 6, 5, 4, 3, 1, 1} {
+		top, err := pq.Pop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if top != num {
+			t.Fatalf("expected %d but got %d", num, top)
+		}
+	}
+}
+
+func TestHeapPriorityQueue_Empty(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+
+	if _, err := pq.Pop(); !errors.Is(err, structures.ErrPriorityQueueEmpty) {
+		t.Fatalf("expected ErrPriorityQueueEmpty but got %v", err)
+	}
+	if _, err := pq.Top(); !errors.Is(err, structures.ErrPriorityQueueEmpty) {
+		t.Fatalf("expected ErrPriorityQueueEmpty but got %v", err)
+	}
+}
+
+func TestHeapPriorityQueue_Top(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	pq.Push(5)
+	pq.Push(2)
+
+	top, err := pq.Top()
+	if err != nil || top != 2 {
+		t.Fatalf("expected 2 but got %d (error: %v)", top, err)
+	}
+
+	if pq.Size() != 2 {
+		t.Fatalf("expected size to be 2 but got %d", pq.Size())
+	}
+}
+
+func TestHeapPriorityQueue_DistinctEqualElements(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[heapTask](lessHeapTasks)
+	pq.Push(heapTask{1, "a"})
+	pq.Push(heapTask{1, "b"})
+	pq.Push(heapTask{1, "c"})
+
+	seen := make(map[string]bool)
+	for pq.Size() > 0 {
+		task, _ := pq.Pop()
+		seen[task.name] = true
+	}
+
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 distinct tasks but got %v", seen)
+	}
+}
+
+func TestHeapPriorityQueue_StableOrder(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[heapTask](lessHeapTasks, structures.WithStableOrder())
+
+	var expected []heapTask
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		task := heapTask{priority: rnd.Intn(5), name: string(rune('a' + i%26))}
+		expected = append(expected, task)
+		pq.Push(task)
+	}
+
+	sort.SliceStable(expected, func(i, j int) bool {
+		return expected[i].priority < expected[j].priority
+	})
+
+	for i, task := range expected {
+		got, _ := pq.Pop()
+		if got != task {
+			t.Fatalf("expected %v at %d but got %v", task, i, got)
+		}
+	}
+}
+
+func TestHeapPriorityQueue_PushMany(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	pq.Push(50)
+
+	values := rand.New(rand.NewSource(1)).Perm(100)
+	pq.PushMany(values...)
+	pq.PushMany(-1)
+
+	if pq.Size() != 102 {
+		t.Fatalf("expected size to be 102 but got %d", pq.Size())
+	}
+
+	expected := append([]int{-1, 50}, values...)
+	sort.Ints(expected)
+
+	for _, num := range expected {
+		top, _ := pq.Pop()
+		if top != num {
+			t.Fatalf("expected %d but got %d", num, top)
+		}
+	}
+}
+
+func TestHeapPriorityQueue_Merge(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[heapTask](lessHeapTasks, structures.WithStableOrder())
+	other := structures.NewHeapPriorityQueue[heapTask](lessHeapTasks, structures.WithStableOrder())
+
+	pq.Push(heapTask{2, "a"})
+	pq.Push(heapTask{1, "b"})
+	other.Push(heapTask{1, "c"})
+	other.Push(heapTask{0, "d"})
+	other.Push(heapTask{1, "e"})
+
+	pq.Merge(other)
+	pq.Merge(pq)
+
+	if other.Size() != 0 {
+		t.Fatalf("expected merged queue to be empty but got %d", other.Size())
+	}
+
+	for _, name := range []string{"d", "b", "c", "e", "a"} {
+		task, _ := pq.Pop()
+		if task.name != name {
+			t.Fatalf("expected %s but got %s", name, task.name)
+		}
+	}
+}
+
+func TestHeapPriorityQueue_Merge_OtherPriorityQueuer(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	other := &wrappedHeapPriorityQueue{structures.NewHeapPriorityQueue[int](lessInts)}
+
+	pq.Push(3)
+	other.Push(1)
+	other.Push(2)
+
+	pq.Merge(other)
+
+	if other.Size() != 0 || pq.Size() != 3 {
+		t.Fatalf("expected sizes 0 and 3 but got %d and %d", other.Size(), pq.Size())
+	}
+
+	for _, num := range []int{1, 2, 3} {
+		top, _ := pq.Pop()
+		if top != num {
+			t.Fatalf("expected %d but got %d", num, top)
+		}
+	}
+}
+
+// wrappedHeapPriorityQueue hides the concrete type of the queue to merge it by popping
+type wrappedHeapPriorityQueue struct {
+	structures.HeapPriorityQueuer[int]
+}
+
+func TestHeapPriorityQueue_RandomOperations(t *testing.T) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	var expected []int
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 5000; i++ {
+		if rnd.Intn(3) == 0 && len(expected) > 0 {
+			sort.Ints(expected)
+			top, _ := pq.Pop()
+			if top != expected[0] {
+				t.Fatalf("expected %d but got %d", expected[0], top)
+			}
+			expected = expected[1:]
+		} else {
+			value := rnd.Intn(100)
+			pq.Push(value)
+			expected = append(expected, value)
+		}
+
+		if pq.Size() != int64(len(expected)) {
+			t.Fatalf("expected size to be %d but got %d", len(expected), pq.Size())
+		}
+	}
+}
+
+func BenchmarkPriorityQueue_PushPop(b *testing.B) {
+	pq := structures.NewPriorityQueue[int](compareInts)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		pq.Push(rnd.Intn(1000))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pq.Push(rnd.Intn(1000))
+		pq.Pop()
+	}
+}
+
+func BenchmarkHeapPriorityQueue_PushPop(b *testing.B) {
+	pq := structures.NewHeapPriorityQueue[int](lessInts)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		pq.Push(rnd.Intn(1000))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pq.Push(rnd.Intn(1000))
+		pq.Pop()
+	}
+}
@@ -0,0 +1,192 @@
+package structures
+
+import (
+	"errors"
+)
+
+var (
+	ErrPriorityQueueKeyExists   = errors.New("key already exists in priority queue")
+	ErrPriorityQueueKeyNotFound = errors.New("key not found in priority queue")
+)
+
+// indexedEntry is a key of the indexed priority queue and its priority
+type indexedEntry[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+// indexedPriorityQueue represents a priority queue of unique keys stored on a binary
+// heap, with a map from every key to its position so priorities can be changed
+type indexedPriorityQueue[K comparable, P any] struct {
+	entries   []indexedEntry[K, P]
+	positions map[K]int
+	less      func(a, b P) bool
+}
+
+// NewIndexedPriorityQueue creates a new indexed priority queue where PopMin retrieves
+// the key with the lowest priority according to less
+func NewIndexedPriorityQueue[K comparable, P any](less func(a, b P) bool) IndexedPriorityQueuer[K, P] {
+	return &indexedPriorityQueue[K, P]{
+		positions: make(map[K]int),
+		less:      less,
+	}
+}
+
+// Push adds a key with its priority
+// If the key is already queued, retrieve an ErrPriorityQueueKeyExists
+func (pq *indexedPriorityQueue[K, P]) Push(key K, priority P) error {
+	if _, exists := pq.positions[key]; exists {
+		return ErrPriorityQueueKeyExists
+	}
+
+	pq.entries = append(pq.entries, indexedEntry[K, P]{key: key, priority: priority})
+	pq.positions[key] = len(pq.entries) - 1
+	pq.siftUp(len(pq.entries) - 1)
+
+	return nil
+}
+
+// Update changes the priority of a queued key
+// If the key is not queued, retrieve an ErrPriorityQueueKeyNotFound
+func (pq *indexedPriorityQueue[K, P]) Update(key K, priority P) error {
+	i, exists := pq.positions[key]
+	if !exists {
+		return ErrPriorityQueueKeyNotFound
+	}
+
+	pq.entries[i].priority = priority
+	pq.fix(i)
+
+	return nil
+}
+
+// Remove removes a queued key
+// If the key is not queued, retrieve an ErrPriorityQueueKeyNotFound
+func (pq *indexedPriorityQueue[K, P]) Remove(key K) error {
+	i, exists := pq.positions[key]
+	if !exists {
+		return ErrPriorityQueueKeyNotFound
+	}
+
+	pq.removeAt(i)
+	return nil
+}
+
+// Contains checks if a key is queued
+func (pq *indexedPriorityQueue[K, P]) Contains(key K) bool {
+	_, exists := pq.positions[key]
+	return exists
+}
+
+// Priority returns the priority of a queued key
+// If the key is not queued, retrieve an ErrPriorityQueueKeyNotFound
+func (pq *indexedPriorityQueue[K, P]) Priority(key K) (P, error) {
+	i, exists := pq.positions[key]
+	if !exists {
+		var zero P
+		return zero, ErrPriorityQueueKeyNotFound
+	}
+
+	return pq.entries[i].priority, nil
+}
+
+// Min returns the key with the lowest priority without removing it
+// If queue is empty, retrieve an ErrPriorityQueueEmpty
+func (pq *indexedPriorityQueue[K, P]) Min() (K, P, error) {
+	if len(pq.entries) == 0 {
+		var key K
+		var priority P
+		return key, priority, ErrPriorityQueueEmpty
+	}
+
+	return pq.entries[0].key, pq.entries[0].priority, nil
+}
+
+// PopMin removes and returns the key with the lowest priority
+// If queue is empty, retrieve an ErrPriorityQueueEmpty
+func (pq *indexedPriorityQueue[K, P]) PopMin() (K, P, error) {
+	if len(pq.entries) == 0 {
+		var key K
+		var priority P
+		return key, priority, ErrPriorityQueueEmpty
+	}
+
+	entry := pq.entries[0]
+	pq.removeAt(0)
+
+	return entry.key, entry.priority, nil
+}
+
+// Size returns the number of keys in the priority queue
+func (pq *indexedPriorityQueue[K, P]) Size() int64 {
+	return int64(len(pq.entries))
+}
+
+// removeAt removes the entry at position i
+func (pq *indexedPriorityQueue[K, P]) removeAt(i int) {
+	last := len(pq.entries) - 1
+	delete(pq.positions, pq.entries[i].key)
+
+	if i != last {
+		pq.entries[i] = pq.entries[last]
+		pq.positions[pq.entries[i].key] = i
+	}
+
+	pq.entries[last] = indexedEntry[K, P]{} // let the garbage collector reclaim the key
+	pq.entries = pq.entries[:last]
+
+	if i != last {
+		pq.fix(i)
+	}
+}
+
+// fix moves the entry at i up or down after its priority changed
+func (pq *indexedPriorityQueue[K, P]) fix(i int) {
+	if i > 0 && pq.less(pq.entries[i].priority, pq.entries[(i-1)/2].priority) {
+		pq.siftUp(i)
+		return
+	}
+	pq.siftDown(i)
+}
+
+// swap exchanges the entries at i and j keeping their positions updated
+func (pq *indexedPriorityQueue[K, P]) swap(i, j int) {
+	pq.entries[i], pq.entries[j] = pq.entries[j], pq.entries[i]
+	pq.positions[pq.entries[i].key] = i
+	pq.positions[pq.entries[j].key] = j
+}
+
+// siftUp moves the entry at i up until its parent has a lower or equal priority
+func (pq *indexedPriorityQueue[K, P]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.entries[i].priority, pq.entries[parent].priority) {
+			return
+		}
+
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// siftDown moves the entry at i down until its children have a greater or equal priority
+func (pq *indexedPriorityQueue[K, P]) siftDown(i int) {
+	n := len(pq.entries)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && pq.less(pq.entries[left].priority, pq.entries[smallest].priority) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.entries[right].priority, pq.entries[smallest].priority) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,160 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewIndexedPriorityQueue(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+	if pq.Size() != 0 {
+		t.Fatalf("expected size to be 0 but got %d", pq.Size())
+	}
+}
+
+func TestIndexedPriorityQueue_PushPopMin(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+	pq.Push("c", 3)
+	pq.Push("a", 1)
+	pq.Push("b", 2)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		key, _, err := pq.PopMin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key != expected {
+			t.Fatalf("expected %s but got %s", expected, key)
+		}
+	}
+
+	if _, _, err := pq.PopMin(); !errors.Is(err, structures.ErrPriorityQueueEmpty) {
+		t.Fatalf("expected ErrPriorityQueueEmpty but got %v", err)
+	}
+}
+
+func TestIndexedPriorityQueue_Push_Duplicate(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+	pq.Push("a", 1)
+
+	if err := pq.Push("a", 2); !errors.Is(err, structures.ErrPriorityQueueKeyExists) {
+		t.Fatalf("expected ErrPriorityQueueKeyExists but got %v", err)
+	}
+}
+
+func TestIndexedPriorityQueue_Update(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+	pq.Push("a", 1)
+	pq.Push("b", 2)
+	pq.Push("c", 3)
+
+	pq.Update("c", 0)
+	pq.Update("a", 5)
+
+	for _, expected := range []string{"c", "b", "a"} {
+		key, _, _ := pq.PopMin()
+		if key != expected {
+			t.Fatalf("expected %s but got %s", expected, key)
+		}
+	}
+
+	if err := pq.Update("a", 1); !errors.Is(err, structures.ErrPriorityQueueKeyNotFound) {
+		t.Fatalf("expected ErrPriorityQueueKeyNotFound but got %v", err)
+	}
+}
+
+func TestIndexedPriorityQueue_Remove(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+	pq.Push("a", 1)
+	pq.Push("b", 2)
+
+	if err := pq.Remove("a"); err != nil {
+		t.Fatal(err)
+	}
+	if pq.Contains("a") || !pq.Contains("b") {
+		t.Fatalf("expected only b to be queued")
+	}
+	if err := pq.Remove("a"); !errors.Is(err, structures.ErrPriorityQueueKeyNotFound) {
+		t.Fatalf("expected ErrPriorityQueueKeyNotFound but got %v", err)
+	}
+}
+
+func TestIndexedPriorityQueue_PriorityAndMin(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[string, int](lessInts)
+
+	if _, _, err := pq.Min(); !errors.Is(err, structures.ErrPriorityQueueEmpty) {
+		t.Fatalf("expected ErrPriorityQueueEmpty but got %v", err)
+	}
+
+	pq.Push("a", 4)
+	pq.Push("b", 2)
+
+	if priority, err := pq.Priority("a"); err != nil || priority != 4 {
+		t.Fatalf("expected 4 but got %d (error: %v)", priority, err)
+	}
+	if _, err := pq.Priority("c"); !errors.Is(err, structures.ErrPriorityQueueKeyNotFound) {
+		t.Fatalf("expected ErrPriorityQueueKeyNotFound but got %v", err)
+	}
+
+	key, priority, _ := pq.Min()
+	if key != "b" || priority != 2 || pq.Size() != 2 {
+		t.Fatalf("expected b with priority 2 but got %s with %d", key, priority)
+	}
+}
+
+func TestIndexedPriorityQueue_RandomOperations(t *testing.T) {
+	pq := structures.NewIndexedPriorityQueue[int, int](lessInts)
+	expected := make(map[int]int)
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 5000; i++ {
+		key := rnd.Intn(100)
+		_, queued := expected[key]
+
+		switch rnd.Intn(4) {
+		case 0:
+			if queued {
+				continue
+			}
+			priority := rnd.Intn(1000)
+			pq.Push(key, priority)
+			expected[key] = priority
+		case 1:
+			if !queued {
+				continue
+			}
+			priority := rnd.Intn(1000)
+			pq.Update(key, priority)
+			expected[key] = priority
+		case 2:
+			if !queued {
+				continue
+			}
+			pq.Remove(key)
+			delete(expected, key)
+		case 3:
+			if len(expected) == 0 {
+				continue
+			}
+			priorities := make([]int, 0, len(expected))
+			for _, priority := range expected {
+				priorities = append(priorities, priority)
+			}
+			sort.Ints(priorities)
+
+			key, priority, _ := pq.PopMin()
+			if priority != priorities[0] || expected[key] != priority {
+				t.Fatalf("expected priority %d but got %d for %d", priorities[0], priority, key)
+			}
+			delete(expected, key)
+		}
+
+		if pq.Size() != int64(len(expected)) {
+			t.Fatalf("expected size to be %d but got %d", len(expected), pq.Size())
+		}
+	}
+}
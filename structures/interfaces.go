@@ -1,6 +1,10 @@
 package structures
 
-import "golang.org/x/exp/constraints"
+import (
+	"context"
+
+	"golang.org/x/exp/constraints"
+)
 
 // Sizer define the size of the structure
 type Sizer[T comparable] interface {
@@ -32,6 +36,38 @@ type Queuer[T comparable] interface {
 	Bottom() (T, error)
 }
 
+// RingQueuer define the operations of a queue stored on a circular slice.
+// When its capacity is fixed and it is full, Push overwrites the oldest element
+// while TryPush fails with ErrQueueFull
+type RingQueuer[T comparable] interface {
+	Queuer[T]
+	TryPush(value T) error
+	Capacity() int
+}
+
+// ConcurrentQueuer define the operations of a queue safe for concurrent use
+type ConcurrentQueuer[T comparable] interface {
+	Queuer[T]
+	PushCtx(ctx context.Context, value T) error
+	PopCtx(ctx context.Context) (T, error)
+	TryPush(value T) error
+	TryPop() (T, error)
+	Close()
+}
+
+// Dequer define the basic operations of a double-ended queue
+type Dequer[T comparable] interface {
+	Sizer[T]
+	Finder[T]
+	PushFront(value T)
+	PushBack(value T)
+	PopFront() (T, error)
+	PopBack() (T, error)
+	Front() (T, error)
+	Back() (T, error)
+	At(index int) (T, error)
+}
+
 // SimpleLinkedLister define the basic operations of a simple linked list
 type SimpleLinkedLister[T comparable] interface {
 	Sizer[T]
@@ -64,6 +100,20 @@ type HashMapper[K comparable, V comparable] interface {
 	GetAt(key K) (V, error)
 }
 
+// OrderedMapper define the operations of a map that keeps its keys sorted
+type OrderedMapper[K comparable, V comparable] interface {
+	HashMapper[K, V]
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	Min() (K, V, error)
+	Max() (K, V, error)
+	Floor(key K) (K, V, error)
+	Ceiling(key K) (K, V, error)
+	Each(fn func(key K, value V) bool)
+	Keys() []K
+}
+
 // BasicTree define the basic operations of a tree
 type BasicTree[T comparable] interface {
 	Sizer[T]
@@ -91,6 +141,45 @@ type PriorityQueuer[T comparable] interface {
 	Top() (T, error)
 }
 
+// HeapPriorityQueuer define the operations of a priority queue stored on a binary heap
+type HeapPriorityQueuer[T comparable] interface {
+	PriorityQueuer[T]
+	PushMany(values ...T)
+	Merge(other HeapPriorityQueuer[T])
+}
+
+// IndexedPriorityQueuer define the operations of a priority queue of unique keys
+// whose priorities can be changed after they are pushed
+type IndexedPriorityQueuer[K comparable, P any] interface {
+	Sizer[K]
+	Push(key K, priority P) error
+	Update(key K, priority P) error
+	Remove(key K) error
+	Contains(key K) bool
+	Priority(key K) (P, error)
+	Min() (K, P, error)
+	PopMin() (K, P, error)
+}
+
+// DisjointSetter define the basic operations of a disjoint-set (union-find)
+type DisjointSetter[T comparable] interface {
+	Sizer[T]
+	MakeSet(value T)
+	Union(a, b T) (bool, error)
+	Find(value T) (T, error)
+	Connected(a, b T) bool
+	SetSize(value T) (int, error)
+	Count() int
+	Sets() [][]T
+}
+
+// RollbackDisjointSetter define the operations of a disjoint-set whose changes can be undone
+type RollbackDisjointSetter[T comparable] interface {
+	DisjointSetter[T]
+	Snapshot() int
+	Rollback(snapshot int) error
+}
+
 type Numeric interface {
 	constraints.Integer | constraints.Float
 }
@@ -110,5 +199,14 @@ type Graph[T comparable, W Numeric] interface {
 	InDegree(vertex T) (int, error)
 	Transpose() Graph[T, W]
 	IsDirected() bool
-	ShortestPath(from, to T) ([]T, error)
+	ShortestPath(from, to T) ([]T, W, error)
+	ShortestPathTree(from T) (*ShortestPathTree[T, W], error)
+}
+
+// FrozenGraph represents a read-only graph whose vertices have dense ids, from 0 to the
+// number of vertices
+type FrozenGraph[T comparable, W Numeric] interface {
+	Graph[T, W]
+	VertexID(vertex T) (int, error)
+	VertexByID(id int) (T, error)
 }
@@ -0,0 +1,320 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrSourceIsSink     = errors.New("source and sink are the same vertex")
+	ErrNegativeCapacity = errors.New("negative edge capacity")
+)
+
+// FlowAlgorithm chooses how MaxFlow finds augmenting paths
+type FlowAlgorithm int
+
+const (
+	// EdmondsKarp augments along shortest paths found by breadth-first search, in O(V·E²)
+	EdmondsKarp FlowAlgorithm = iota
+	// Dinic augments blocking flows over a level graph, in O(V²·E)
+	Dinic
+)
+
+// MaxFlowOption configures a maximum flow search
+type MaxFlowOption func(*maxFlowConfig)
+
+// maxFlowConfig stores the policies used by the maximum flow search
+type maxFlowConfig struct {
+	algorithm FlowAlgorithm
+	epsilon   float64
+	hasEps    bool
+}
+
+// WithFlowAlgorithm sets the algorithm used by MaxFlow. The default is Dinic
+func WithFlowAlgorithm(algorithm FlowAlgorithm) MaxFlowOption {
+	return func(c *maxFlowConfig) {
+		c.algorithm = algorithm
+	}
+}
+
+// WithFlowEpsilon sets the capacity below which an edge is considered saturated, which
+// avoids endless augmentations by rounding errors with float capacities. The default
+// is NumericEpsilon
+func WithFlowEpsilon(epsilon float64) MaxFlowOption {
+	return func(c *maxFlowConfig) {
+		c.epsilon = epsilon
+		c.hasEps = true
+	}
+}
+
+// MaxFlowResult holds a maximum flow and the minimum cut it saturates
+type MaxFlowResult[T comparable, W Numeric] struct {
+	// Value is the total flow from the source to the sink
+	Value W
+	// Flows lists every edge carrying flow, with the flow as weight
+	Flows []Edge[T, W]
+	// SourceSide lists the vertices still reachable from the source through unsaturated
+	// edges, the others are in SinkSide
+	SourceSide []T
+	SinkSide   []T
+	// CutEdges lists the edges from the source side to the sink side, with their
+	// capacity as weight, which add up to Value
+	CutEdges []Edge[T, W]
+}
+
+// MaxFlow finds the maximum flow from source to sink in a directed graph whose weights
+// are the capacities of the edges, and the minimum cut separating them.
+// If the graph is undirected, retrieve an ErrUndirectedGraph; if a capacity is negative,
+// an ErrNegativeCapacity
+func MaxFlow[T comparable, W Numeric](g Graph[T, W], source, sink T, opts ...MaxFlowOption) (*MaxFlowResult[T, W], error) {
+	config := maxFlowConfig{algorithm: Dinic}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	epsilon := NumericEpsilon[W]()
+	if config.hasEps {
+		epsilon = W(config.epsilon)
+	}
+
+	network, err := newFlowNetwork(g, source, sink, epsilon, func(edge Edge[T, W]) W { return edge.Weight }, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := network.index[source], network.index[sink]
+	var value W
+	switch config.algorithm {
+	case EdmondsKarp:
+		value = network.edmondsKarp(s, t)
+	default:
+		value = network.dinic(s, t)
+	}
+
+	result := &MaxFlowResult[T, W]{Value: value}
+	for i, edge := range network.edges {
+		if flow := network.flow(i); flow > epsilon {
+			result.Flows = append(result.Flows, Edge[T, W]{From: edge.From, To: edge.To, Weight: flow})
+		}
+	}
+
+	reachable := network.reachable(s)
+	for i, vertex := range network.vertices {
+		if reachable[i] {
+			result.SourceSide = append(result.SourceSide, vertex)
+		} else {
+			result.SinkSide = append(result.SinkSide, vertex)
+		}
+	}
+	for _, edge := range network.edges {
+		if reachable[network.index[edge.From]] && !reachable[network.index[edge.To]] {
+			result.CutEdges = append(result.CutEdges, edge)
+		}
+	}
+
+	return result, nil
+}
+
+// flowNetwork is the residual network of a graph, where every edge i is the arc 2i and
+// its reverse, which returns flow, is the arc 2i+1
+type flowNetwork[T comparable, W Numeric] struct {
+	vertices []T
+	index    map[T]int
+	edges    []Edge[T, W]
+	arcs     [][]int // arcs leaving every vertex
+	to       []int
+	residual []W
+	cost     []W // cost of a unit of flow, only set for cost-aware flows
+	epsilon  W
+}
+
+// newFlowNetwork creates the residual network of a directed graph with no flow, reading
+// the capacity and, when set, the cost of every edge through the accessors
+func newFlowNetwork[T comparable, W Numeric](g Graph[T, W], source, sink T, epsilon W, capacity, cost func(edge Edge[T, W]) W) (*flowNetwork[T, W], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+	if _, err := g.Neighbors(source); err != nil {
+		return nil, err
+	}
+	if _, err := g.Neighbors(sink); err != nil {
+		return nil, err
+	}
+	if source == sink {
+		return nil, ErrSourceIsSink
+	}
+
+	network := &flowNetwork[T, W]{
+		vertices: g.Vertices(),
+		index:    make(map[T]int),
+		epsilon:  epsilon,
+	}
+	network.arcs = make([][]int, len(network.vertices))
+	for i, vertex := range network.vertices {
+		network.index[vertex] = i
+	}
+
+	for _, edge := range g.Edges() {
+		c := capacity(edge)
+		if c < 0 {
+			return nil, fmt.Errorf("%w: %v -> %v", ErrNegativeCapacity, edge.From, edge.To)
+		}
+		// a self-loop never carries flow from the source to the sink
+		if edge.From == edge.To {
+			continue
+		}
+
+		from, to := network.index[edge.From], network.index[edge.To]
+		network.edges = append(network.edges, edge)
+		network.addArc(from, to, c)
+		network.addArc(to, from, 0)
+
+		if cost != nil {
+			unit := cost(edge)
+			network.cost = append(network.cost, unit, -unit)
+		}
+	}
+
+	return network, nil
+}
+
+// addArc adds an arc with the given residual capacity
+func (n *flowNetwork[T, W]) addArc(from, to int, residual W) {
+	n.arcs[from] = append(n.arcs[from], len(n.to))
+	n.to = append(n.to, to)
+	n.residual = append(n.residual, residual)
+}
+
+// open checks if more flow can go through the arc
+func (n *flowNetwork[T, W]) open(arc int) bool {
+	return n.residual[arc] > n.epsilon
+}
+
+// push sends flow through the arc, making room on its reverse
+func (n *flowNetwork[T, W]) push(arc int, flow W) {
+	n.residual[arc] -= flow
+	n.residual[arc^1] += flow
+}
+
+// flow returns the flow going through the edge i
+func (n *flowNetwork[T, W]) flow(i int) W {
+	return n.residual[2*i+1]
+}
+
+// levels returns the number of open arcs from s to every vertex, -1 when unreachable
+func (n *flowNetwork[T, W]) levels(s int) []int {
+	level := make([]int, len(n.vertices))
+	for i := range level {
+		level[i] = -1
+	}
+	level[s] = 0
+
+	queue := []int{s}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, arc := range n.arcs[v] {
+			if next := n.to[arc]; level[next] == -1 && n.open(arc) {
+				level[next] = level[v] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return level
+}
+
+// reachable marks the vertices reachable from s through open arcs
+func (n *flowNetwork[T, W]) reachable(s int) []bool {
+	level := n.levels(s)
+	reachable := make([]bool, len(level))
+	for i, l := range level {
+		reachable[i] = l >= 0
+	}
+	return reachable
+}
+
+// edmondsKarp saturates the network from s to t along shortest augmenting paths
+func (n *flowNetwork[T, W]) edmondsKarp(s, t int) W {
+	var total W
+	through := make([]int, len(n.vertices)) // arc used to reach every vertex
+
+	for {
+		for i := range through {
+			through[i] = -1
+		}
+
+		queue := []int{s}
+		for len(queue) > 0 && through[t] == -1 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, arc := range n.arcs[v] {
+				if next := n.to[arc]; next != s && through[next] == -1 && n.open(arc) {
+					through[next] = arc
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		if through[t] == -1 {
+			return total
+		}
+
+		bottleneck := n.residual[through[t]]
+		for v := t; v != s; v = n.to[through[v]^1] {
+			bottleneck = min(bottleneck, n.residual[through[v]])
+		}
+		for v := t; v != s; v = n.to[through[v]^1] {
+			n.push(through[v], bottleneck)
+		}
+		total += bottleneck
+	}
+}
+
+// dinic saturates the network from s to t with blocking flows over level graphs
+func (n *flowNetwork[T, W]) dinic(s, t int) W {
+	var total W
+	next := make([]int, len(n.vertices)) // first arc of every vertex not tried yet
+
+	for {
+		level := n.levels(s)
+		if level[t] == -1 {
+			return total
+		}
+
+		for i := range next {
+			next[i] = 0
+		}
+
+		// augment sends at most limit from v to t, returning what it sent
+		var augment func(v int, limit W) W
+		augment = func(v int, limit W) W {
+			if v == t {
+				return limit
+			}
+
+			for ; next[v] < len(n.arcs[v]); next[v]++ {
+				arc := n.arcs[v][next[v]]
+				to := n.to[arc]
+				if level[to] != level[v]+1 || !n.open(arc) {
+					continue
+				}
+
+				if sent := augment(to, min(limit, n.residual[arc])); sent > n.epsilon {
+					n.push(arc, sent)
+					return sent
+				}
+			}
+
+			return 0
+		}
+
+		for {
+			sent := augment(s, NumericMaxValue[W]())
+			if sent <= n.epsilon {
+				break
+			}
+			total += sent
+		}
+	}
+}
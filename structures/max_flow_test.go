@@ -0,0 +1,225 @@
+package structures_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// flowAlgorithms lists every maximum flow algorithm
+var flowAlgorithms = map[string]structures.FlowAlgorithm{
+	"EdmondsKarp": structures.EdmondsKarp,
+	"Dinic":       structures.Dinic,
+}
+
+// checkFlow fails the test if the flow breaks a capacity or is not conserved, or if the
+// cut does not separate source and sink with the capacity of the flow
+func checkFlow[W structures.Numeric](t *testing.T, g structures.Graph[string, W], source, sink string, result *structures.MaxFlowResult[string, W], epsilon W) {
+	t.Helper()
+
+	balance := make(map[string]W)
+	for _, edge := range result.Flows {
+		capacity, err := g.Weight(edge.From, edge.To)
+		if err != nil || edge.Weight > capacity+epsilon || edge.Weight < 0 {
+			t.Fatalf("flow %v breaks the capacity %v", edge, capacity)
+		}
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+	}
+
+	for vertex, b := range balance {
+		if vertex != source && vertex != sink && (b > epsilon || b < -epsilon) {
+			t.Fatalf("flow is not conserved at %v: %v", vertex, b)
+		}
+	}
+	if diff := balance[sink] - result.Value; diff > epsilon || diff < -epsilon {
+		t.Fatalf("expected %v to reach the sink, got %v", result.Value, balance[sink])
+	}
+
+	sourceSide := make(map[string]bool)
+	for _, vertex := range result.SourceSide {
+		sourceSide[vertex] = true
+	}
+	if !sourceSide[source] || sourceSide[sink] || len(result.SourceSide)+len(result.SinkSide) != len(g.Vertices()) {
+		t.Fatalf("expected a partition separating %v from %v, got %v and %v", source, sink, result.SourceSide, result.SinkSide)
+	}
+
+	var cut W
+	for _, edge := range result.CutEdges {
+		if !sourceSide[edge.From] || sourceSide[edge.To] {
+			t.Fatalf("cut edge %v does not cross the partition", edge)
+		}
+		cut += edge.Weight
+	}
+	if diff := cut - result.Value; diff > epsilon || diff < -epsilon {
+		t.Fatalf("expected cut capacity %v, got %v", result.Value, cut)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	for name, algorithm := range flowAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "s", "v1", "v2", "v3", "v4", "t")
+				g.AddEdge("s", "v1", 16)
+				g.AddEdge("s", "v2", 13)
+				g.AddEdge("v2", "v1", 4)
+				g.AddEdge("v1", "v3", 12)
+				g.AddEdge("v3", "v2", 9)
+				g.AddEdge("v2", "v4", 14)
+				g.AddEdge("v4", "v3", 7)
+				g.AddEdge("v3", "t", 20)
+				g.AddEdge("v4", "t", 4)
+
+				result, err := structures.MaxFlow(g, "s", "t", structures.WithFlowAlgorithm(algorithm))
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if result.Value != 23 {
+					t.Fatalf("expected flow 23, got %d", result.Value)
+				}
+				checkFlow(t, g, "s", "t", result, 0)
+			})
+		})
+	}
+}
+
+func TestMaxFlow_AntiparallelEdges(t *testing.T) {
+	for name, algorithm := range flowAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "s", "a", "b", "t")
+				g.AddEdge("s", "a", 5)
+				g.AddEdge("s", "b", 2)
+				g.AddEdge("a", "b", 3)
+				g.AddEdge("b", "a", 3)
+				g.AddEdge("a", "t", 2)
+				g.AddEdge("b", "t", 5)
+				g.AddEdge("a", "a", 9)
+
+				result, _ := structures.MaxFlow(g, "s", "t", structures.WithFlowAlgorithm(algorithm))
+				if result.Value != 7 {
+					t.Fatalf("expected flow 7, got %d", result.Value)
+				}
+				checkFlow(t, g, "s", "t", result, 0)
+			})
+		})
+	}
+}
+
+func TestMaxFlow_Unreachable(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "s", "a", "t")
+		g.AddEdge("s", "a", 5)
+		g.AddEdge("t", "a", 5)
+
+		result, err := structures.MaxFlow(g, "s", "t")
+		if err != nil || result.Value != 0 || len(result.Flows) != 0 || len(result.CutEdges) != 0 {
+			t.Fatalf("expected no flow, got %+v (error: %v)", result, err)
+		}
+	})
+}
+
+func TestMaxFlow_Float(t *testing.T) {
+	for name, algorithm := range flowAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			g := structures.NewAdjacencyListGraph[string, float64](true)
+			for _, vertex := range []string{"s", "a", "b", "c", "t"} {
+				g.AddVertex(vertex)
+			}
+			g.AddEdge("s", "a", 0.1)
+			g.AddEdge("s", "b", 0.2)
+			g.AddEdge("a", "b", 0.3)
+			g.AddEdge("b", "c", 0.1+0.2)
+			g.AddEdge("a", "c", 1.0/3)
+			g.AddEdge("c", "t", 0.3)
+
+			result, err := structures.MaxFlow(g, "s", "t", structures.WithFlowAlgorithm(algorithm))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if math.Abs(result.Value-0.3) > 1e-9 {
+				t.Fatalf("expected flow 0.3, got %v", result.Value)
+			}
+			checkFlow(t, g, "s", "t", result, 1e-9)
+		})
+	}
+}
+
+func TestMaxFlow_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		for _, constructor := range graphConstructors {
+			g := constructor.newGraph(true)
+			vertices := make([]string, 10)
+			for i := range vertices {
+				vertices[i] = string(rune('a' + i))
+				g.AddVertex(vertices[i])
+			}
+			for i := 0; i < 30; i++ {
+				g.AddEdge(vertices[rnd.Intn(10)], vertices[rnd.Intn(10)], rnd.Intn(10))
+			}
+
+			edmondsKarp, _ := structures.MaxFlow(g, "a", "j", structures.WithFlowAlgorithm(structures.EdmondsKarp))
+			dinic, _ := structures.MaxFlow(g, "a", "j", structures.WithFlowAlgorithm(structures.Dinic))
+
+			checkFlow(t, g, "a", "j", edmondsKarp, 0)
+			checkFlow(t, g, "a", "j", dinic, 0)
+			if edmondsKarp.Value != dinic.Value {
+				t.Fatalf("%s: Edmonds-Karp found %d, Dinic %d", constructor.name, edmondsKarp.Value, dinic.Value)
+			}
+		}
+	}
+}
+
+func TestMaxFlow_RandomFloatGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		g := structures.NewAdjacencyListGraph[string, float64](true)
+		vertices := make([]string, 10)
+		for i := range vertices {
+			vertices[i] = string(rune('a' + i))
+			g.AddVertex(vertices[i])
+		}
+		for i := 0; i < 30; i++ {
+			g.AddEdge(vertices[rnd.Intn(10)], vertices[rnd.Intn(10)], rnd.Float64())
+		}
+
+		edmondsKarp, _ := structures.MaxFlow(g, "a", "j", structures.WithFlowAlgorithm(structures.EdmondsKarp))
+		dinic, _ := structures.MaxFlow(g, "a", "j", structures.WithFlowAlgorithm(structures.Dinic))
+
+		checkFlow(t, g, "a", "j", edmondsKarp, 1e-6)
+		checkFlow(t, g, "a", "j", dinic, 1e-6)
+		if math.Abs(edmondsKarp.Value-dinic.Value) > 1e-6 {
+			t.Fatalf("Edmonds-Karp found %v, Dinic %v", edmondsKarp.Value, dinic.Value)
+		}
+	}
+}
+
+func TestMaxFlow_Errors(t *testing.T) {
+	directed := structures.NewAdjacencyListGraph[string, int](true)
+	directed.AddVertex("s")
+	directed.AddVertex("t")
+	directed.AddEdge("s", "t", -1)
+
+	_, err := structures.MaxFlow(directed, "s", "t")
+	if !errors.Is(err, structures.ErrNegativeCapacity) || err.Error() != "negative edge capacity: s -> t" {
+		t.Fatalf("expected ErrNegativeCapacity, got %v", err)
+	}
+	if _, err := structures.MaxFlow(directed, "s", "s"); !errors.Is(err, structures.ErrSourceIsSink) {
+		t.Fatalf("expected ErrSourceIsSink, got %v", err)
+	}
+	if _, err := structures.MaxFlow(directed, "s", "z"); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+
+	undirected := structures.NewAdjacencyListGraph[string, int](false)
+	undirected.AddVertex("s")
+	undirected.AddVertex("t")
+	if _, err := structures.MaxFlow(undirected, "s", "t"); !errors.Is(err, structures.ErrUndirectedGraph) {
+		t.Fatalf("expected ErrUndirectedGraph, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package structures
+
+// MinCostFlowResult holds a maximum flow of minimum cost
+type MinCostFlowResult[T comparable, W Numeric] struct {
+	// Value is the total flow from the source to the sink
+	Value W
+	// Cost is the total cost of the flow
+	Cost W
+	// Flows lists every edge carrying flow, with the flow as weight
+	Flows []Edge[T, W]
+}
+
+// MinCostFlow finds the maximum flow from source to sink in a directed graph whose cost is
+// the lowest, reading the capacity and the cost of a unit of flow of every edge through
+// the accessors. It sends flow along successive shortest paths, using potentials so
+// every search is a Dijkstra's search over non-negative reduced costs.
+// Costs may be negative, but if a cycle of edges with capacity has a negative cost,
+// retrieve a *NegativeCycleError. If the graph is undirected, retrieve an
+// ErrUndirectedGraph; if a capacity is negative, an ErrNegativeCapacity
+func MinCostFlow[T comparable, W Numeric](g Graph[T, W], source, sink T, capacity, cost func(edge Edge[T, W]) W) (*MinCostFlowResult[T, W], error) {
+	epsilon := NumericEpsilon[W]()
+	network, err := newFlowNetwork(g, source, sink, epsilon, capacity, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	potentials, err := network.potentials()
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := network.index[source], network.index[sink]
+	result := &MinCostFlowResult[T, W]{}
+	zero := NumericZeroValue[W]()
+	through := make([]int, len(network.vertices)) // arc used to reach every vertex
+
+	for {
+		distances := network.reducedDistances(s, potentials, through)
+		if _, reachable := distances[t]; !reachable {
+			break
+		}
+		for v, distance := range distances {
+			potentials[v] += distance
+		}
+
+		bottleneck := network.residual[through[t]]
+		for v := t; v != s; v = network.to[through[v]^1] {
+			bottleneck = min(bottleneck, network.residual[through[v]])
+		}
+
+		pathCost := zero
+		for v := t; v != s; v = network.to[through[v]^1] {
+			network.push(through[v], bottleneck)
+			pathCost += network.cost[through[v]]
+		}
+
+		result.Value += bottleneck
+		result.Cost += bottleneck * pathCost
+	}
+
+	for i, edge := range network.edges {
+		if flow := network.flow(i); flow > epsilon {
+			result.Flows = append(result.Flows, Edge[T, W]{From: edge.From, To: edge.To, Weight: flow})
+		}
+	}
+
+	return result, nil
+}
+
+// potentials returns a potential for every vertex such that no open arc has a negative
+// reduced cost, computed with Bellman-Ford from a virtual vertex joined to all of them
+func (n *flowNetwork[T, W]) potentials() ([]W, error) {
+	potentials := make([]W, len(n.vertices))
+
+	var edges []Edge[int, W]
+	for arc := range n.to {
+		if n.open(arc) {
+			edges = append(edges, Edge[int, W]{From: n.to[arc^1], To: n.to[arc], Weight: n.cost[arc]})
+		}
+	}
+
+	distances := make(map[int]W, len(n.vertices))
+	for v := range n.vertices {
+		distances[v] = 0
+	}
+
+	previous := make(map[int]int)
+	if v, found := relaxEdges(edges, distances, previous, len(n.vertices)); found {
+		cycle := negativeCycle(previous, v, len(n.vertices))
+		vertices := make([]T, len(cycle))
+		for i, u := range cycle {
+			vertices[i] = n.vertices[u]
+		}
+		return nil, &NegativeCycleError[T]{Cycle: vertices}
+	}
+
+	for v, distance := range distances {
+		potentials[v] = distance
+	}
+	return potentials, nil
+}
+
+// reducedDistances finds the cheapest paths from s through open arcs with Dijkstra's
+// algorithm over the costs reduced by the potentials, recording the arc used to reach
+// every vertex. Only reached vertices have a distance
+func (n *flowNetwork[T, W]) reducedDistances(s int, potentials []W, through []int) map[int]W {
+	zero := NumericZeroValue[W]()
+	distances := map[int]W{s: zero}
+	pq := NewIndexedPriorityQueue[int, W](func(a, b W) bool {
+		return a < b
+	})
+	pq.Push(s, zero)
+
+	for pq.Size() > 0 {
+		v, distance, _ := pq.PopMin()
+
+		for _, arc := range n.arcs[v] {
+			if !n.open(arc) {
+				continue
+			}
+
+			next := n.to[arc]
+			// rounding may leave float reduced costs slightly below zero
+			alt := distance + max(n.cost[arc]+potentials[v]-potentials[next], zero)
+			if known, visited := distances[next]; visited && alt >= known {
+				continue
+			}
+
+			distances[next] = alt
+			through[next] = arc
+			if pq.Contains(next) {
+				pq.Update(next, alt)
+			} else {
+				pq.Push(next, alt)
+			}
+		}
+	}
+
+	return distances
+}
@@ -0,0 +1,260 @@
+package structures_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// edgeAccessor reads a value of every edge from a table, keyed by its endpoints
+func edgeAccessor[W structures.Numeric](table map[[2]string]W) func(edge structures.Edge[string, W]) W {
+	return func(edge structures.Edge[string, W]) W {
+		return table[[2]string{edge.From, edge.To}]
+	}
+}
+
+// checkCostFlow fails the test if the flow breaks a capacity or is not conserved, or if
+// its cost does not match the cost of its edges
+func checkCostFlow[W structures.Numeric](t *testing.T, g structures.Graph[string, W], source, sink string, result *structures.MinCostFlowResult[string, W], capacity, cost func(edge structures.Edge[string, W]) W, epsilon W) {
+	t.Helper()
+
+	balance := make(map[string]W)
+	var total W
+	for _, edge := range result.Flows {
+		weight, err := g.Weight(edge.From, edge.To)
+		if err != nil {
+			t.Fatalf("flow %v is not on an edge", edge)
+		}
+		original := structures.Edge[string, W]{From: edge.From, To: edge.To, Weight: weight}
+		if c := capacity(original); edge.Weight > c+epsilon || edge.Weight < 0 {
+			t.Fatalf("flow %v breaks the capacity %v", edge, c)
+		}
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+		total += edge.Weight * cost(original)
+	}
+
+	for vertex, b := range balance {
+		if vertex != source && vertex != sink && (b > epsilon || b < -epsilon) {
+			t.Fatalf("flow is not conserved at %v: %v", vertex, b)
+		}
+	}
+	if diff := balance[sink] - result.Value; diff > epsilon || diff < -epsilon {
+		t.Fatalf("expected %v to reach the sink, got %v", result.Value, balance[sink])
+	}
+	if diff := total - result.Cost; diff > epsilon || diff < -epsilon {
+		t.Fatalf("expected cost %v, got %v", total, result.Cost)
+	}
+}
+
+// residualNegativeCycle checks if the residual network of the flow has a negative cycle,
+// which would make the flow cheaper without changing its value
+func residualNegativeCycle(t *testing.T, g structures.Graph[string, int], result *structures.MinCostFlowResult[string, int], capacity, cost func(edge structures.Edge[string, int]) int) bool {
+	t.Helper()
+
+	flows := make(map[[2]string]int)
+	for _, edge := range result.Flows {
+		flows[[2]string{edge.From, edge.To}] = edge.Weight
+	}
+
+	residual := structures.NewAdjacencyListGraph[string, int](true)
+	residual.AddVertex("*")
+	for _, vertex := range g.Vertices() {
+		residual.AddVertex(vertex)
+		residual.AddEdge("*", vertex, 0)
+	}
+
+	addArc := func(from, to string, c int) {
+		if known, err := residual.Weight(from, to); err == nil && known <= c {
+			return
+		}
+		residual.AddEdge(from, to, c)
+	}
+	for _, edge := range g.Edges() {
+		if edge.From == edge.To {
+			continue
+		}
+		flow := flows[[2]string{edge.From, edge.To}]
+		if flow < capacity(edge) {
+			addArc(edge.From, edge.To, cost(edge))
+		}
+		if flow > 0 {
+			addArc(edge.To, edge.From, -cost(edge))
+		}
+	}
+
+	_, err := structures.BellmanFord[string, int](residual, "*")
+	return errors.Is(err, structures.ErrNegativeCycle)
+}
+
+func TestMinCostFlow(t *testing.T) {
+	capacities := map[[2]string]int{
+		{"s", "a"}: 4, {"s", "b"}: 2, {"a", "b"}: 2, {"a", "t"}: 2, {"b", "t"}: 3,
+	}
+	costs := map[[2]string]int{
+		{"s", "a"}: 1, {"s", "b"}: 2, {"a", "b"}: 1, {"a", "t"}: 3, {"b", "t"}: 1,
+	}
+	capacity, cost := edgeAccessor(capacities), edgeAccessor(costs)
+
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "s", "a", "b", "t")
+		for endpoints := range capacities {
+			g.AddEdge(endpoints[0], endpoints[1], 0)
+		}
+
+		result, err := structures.MinCostFlow(g, "s", "t", capacity, cost)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Value != 5 || result.Cost != 17 {
+			t.Fatalf("expected flow 5 with cost 17, got %d with cost %d", result.Value, result.Cost)
+		}
+		checkCostFlow(t, g, "s", "t", result, capacity, cost, 0)
+	})
+}
+
+func TestMinCostFlow_ExpensivePaths(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "s", "a", "b", "t")
+		g.AddEdge("s", "a", 1)
+		g.AddEdge("a", "t", 1)
+		g.AddEdge("s", "b", 5)
+		g.AddEdge("b", "t", 5)
+
+		// the flow must be maximum, even through the expensive path
+		unit := func(structures.Edge[string, int]) int { return 1 }
+		result, _ := structures.MinCostFlow(g, "s", "t", unit, func(edge structures.Edge[string, int]) int {
+			return edge.Weight
+		})
+		if result.Value != 2 || result.Cost != 12 {
+			t.Fatalf("expected flow 2 with cost 12, got %d with cost %d", result.Value, result.Cost)
+		}
+	})
+}
+
+func TestMinCostFlow_NegativeCosts(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "s", "a", "t")
+		g.AddEdge("s", "a", -5)
+		g.AddEdge("a", "t", 1)
+		g.AddEdge("s", "t", 2)
+
+		unit := func(structures.Edge[string, int]) int { return 1 }
+		weight := func(edge structures.Edge[string, int]) int { return edge.Weight }
+		result, err := structures.MinCostFlow(g, "s", "t", unit, weight)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Value != 2 || result.Cost != -2 {
+			t.Fatalf("expected flow 2 with cost -2, got %d with cost %d", result.Value, result.Cost)
+		}
+		checkCostFlow(t, g, "s", "t", result, unit, weight, 0)
+	})
+}
+
+func TestMinCostFlow_NegativeCycle(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "s", "a", "b", "t")
+		g.AddEdge("s", "a", 1)
+		g.AddEdge("a", "b", -3)
+		g.AddEdge("b", "a", 1)
+		g.AddEdge("b", "t", 1)
+
+		unit := func(structures.Edge[string, int]) int { return 1 }
+		_, err := structures.MinCostFlow(g, "s", "t", unit, func(edge structures.Edge[string, int]) int {
+			return edge.Weight
+		})
+		checkNegativeCycle(t, g, err)
+	})
+}
+
+func TestMinCostFlow_Float(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, float64](true)
+	for _, vertex := range []string{"s", "a", "b", "t"} {
+		g.AddVertex(vertex)
+	}
+	g.AddEdge("s", "a", 0.1)
+	g.AddEdge("s", "b", 0.2)
+	g.AddEdge("a", "t", 0.3)
+	g.AddEdge("b", "t", 0.1)
+
+	capacity := func(edge structures.Edge[string, float64]) float64 { return edge.Weight }
+	cost := func(edge structures.Edge[string, float64]) float64 { return 1 / edge.Weight }
+	result, err := structures.MinCostFlow(g, "s", "t", capacity, cost)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// 0.1 through a at 10+10/3, and 0.1 through b at 5+10
+	if math.Abs(result.Value-0.2) > 1e-9 || math.Abs(result.Cost-(0.1*(10+10.0/3)+0.1*15)) > 1e-9 {
+		t.Fatalf("expected flow 0.2 with cost %v, got %v with cost %v", 0.1*(10+10.0/3)+0.1*15, result.Value, result.Cost)
+	}
+	checkCostFlow(t, g, "s", "t", result, capacity, cost, 1e-9)
+}
+
+func TestMinCostFlow_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		for _, constructor := range graphConstructors {
+			g := constructor.newGraph(true)
+			vertices := make([]string, 10)
+			for i := range vertices {
+				vertices[i] = string(rune('a' + i))
+				g.AddVertex(vertices[i])
+			}
+
+			costs := make(map[[2]string]int)
+			for i := 0; i < 30; i++ {
+				from, to := vertices[rnd.Intn(10)], vertices[rnd.Intn(10)]
+				g.AddEdge(from, to, rnd.Intn(10))
+				costs[[2]string{from, to}] = rnd.Intn(20) - 2
+			}
+
+			capacity := func(edge structures.Edge[string, int]) int { return edge.Weight }
+			cost := edgeAccessor(costs)
+			result, err := structures.MinCostFlow(g, "a", "j", capacity, cost)
+			if errors.Is(err, structures.ErrNegativeCycle) {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %v", constructor.name, err)
+			}
+
+			checkCostFlow(t, g, "a", "j", result, capacity, cost, 0)
+			if cheaper := residualNegativeCycle(t, g, result, capacity, cost); cheaper {
+				t.Fatalf("%s: expected a flow of minimum cost, got cost %d", constructor.name, result.Cost)
+			}
+			maxFlow, _ := structures.MaxFlow(g, "a", "j")
+			if result.Value != maxFlow.Value {
+				t.Fatalf("%s: expected flow %d, got %d", constructor.name, maxFlow.Value, result.Value)
+			}
+		}
+	}
+}
+
+func TestMinCostFlow_Errors(t *testing.T) {
+	weight := func(edge structures.Edge[string, int]) int { return edge.Weight }
+
+	directed := structures.NewAdjacencyListGraph[string, int](true)
+	directed.AddVertex("s")
+	directed.AddVertex("t")
+	directed.AddEdge("s", "t", -1)
+
+	_, err := structures.MinCostFlow(directed, "s", "t", weight, weight)
+	if !errors.Is(err, structures.ErrNegativeCapacity) || strings.Contains(err.Error(), "BellmanFord") {
+		t.Fatalf("expected ErrNegativeCapacity without the BellmanFord hint, got %v", err)
+	}
+	if _, err := structures.MinCostFlow(directed, "s", "s", weight, weight); !errors.Is(err, structures.ErrSourceIsSink) {
+		t.Fatalf("expected ErrSourceIsSink, got %v", err)
+	}
+
+	undirected := structures.NewAdjacencyListGraph[string, int](false)
+	undirected.AddVertex("s")
+	undirected.AddVertex("t")
+	if _, err := structures.MinCostFlow(undirected, "s", "t", weight, weight); !errors.Is(err, structures.ErrUndirectedGraph) {
+		t.Fatalf("expected ErrUndirectedGraph, got %v", err)
+	}
+}
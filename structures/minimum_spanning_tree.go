@@ -0,0 +1,112 @@
+package structures
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrDirectedGraph = errors.New("operation requires an undirected graph")
+)
+
+// Kruskal finds a minimum spanning forest of an undirected graph, one tree per connected
+// component, adding the lightest edges that join two different trees. It returns the
+// edges of the forest and their total weight.
+// If the graph is directed, retrieve an ErrDirectedGraph
+func Kruskal[T comparable, W Numeric](g Graph[T, W]) ([]Edge[T, W], W, error) {
+	if g.IsDirected() {
+		return nil, 0, ErrDirectedGraph
+	}
+
+	vertices := g.Vertices()
+	ds := NewDisjointSet[T]()
+	for _, vertex := range vertices {
+		ds.MakeSet(vertex)
+	}
+
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Edge[T, W]) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	var forest []Edge[T, W]
+	var total W
+	for _, edge := range edges {
+		if len(forest) == len(vertices)-1 {
+			break
+		}
+
+		if merged, _ := ds.Union(edge.From, edge.To); merged {
+			forest = append(forest, edge)
+			total += edge.Weight
+		}
+	}
+
+	return forest, total, nil
+}
+
+// Prim finds a minimum spanning forest of an undirected graph, growing a tree from start
+// with the lightest edge leaving it, and then from every vertex not reached yet. It
+// returns the edges of the forest, each going from the tree to the vertex it added,
+// and their total weight.
+// If the graph is directed, retrieve an ErrDirectedGraph
+func Prim[T comparable, W Numeric](g Graph[T, W], start T) ([]Edge[T, W], W, error) {
+	if g.IsDirected() {
+		return nil, 0, ErrDirectedGraph
+	}
+	if _, err := g.Neighbors(start); err != nil {
+		return nil, 0, err
+	}
+
+	inTree := make(map[T]bool)
+	lightest := make(map[T]Edge[T, W]) // lightest edge joining every queued vertex to the tree
+	pq := NewIndexedPriorityQueue[T, W](func(a, b W) bool {
+		return a < b
+	})
+
+	var forest []Edge[T, W]
+	var total W
+
+	for _, root := range append([]T{start}, g.Vertices()...) {
+		if inTree[root] {
+			continue
+		}
+
+		pq.Push(root, NumericZeroValue[W]())
+		for pq.Size() > 0 {
+			vertex, _, _ := pq.PopMin()
+			inTree[vertex] = true
+			if edge, ok := lightest[vertex]; ok {
+				forest = append(forest, edge)
+				total += edge.Weight
+			}
+
+			neighbors, _ := g.Neighbors(vertex)
+			for neighbor, weight := range neighbors {
+				if inTree[neighbor] {
+					continue
+				}
+
+				if edge, ok := lightest[neighbor]; ok && edge.Weight <= weight {
+					continue
+				}
+
+				lightest[neighbor] = Edge[T, W]{From: vertex, To: neighbor, Weight: weight}
+				if pq.Contains(neighbor) {
+					pq.Update(neighbor, weight)
+				} else {
+					pq.Push(neighbor, weight)
+				}
+			}
+		}
+	}
+
+	return forest, total, nil
+}
@@ -0,0 +1,150 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// spanningForestAlgorithms lists both minimum spanning forest algorithms, Prim starting at A
+var spanningForestAlgorithms = map[string]func(structures.Graph[string, int]) ([]structures.Edge[string, int], int, error){
+	"Kruskal": structures.Kruskal[string, int],
+	"Prim": func(g structures.Graph[string, int]) ([]structures.Edge[string, int], int, error) {
+		return structures.Prim(g, "A")
+	},
+}
+
+// checkSpanningForest fails the test if the edges are not a forest of the graph joining
+// every connected component
+func checkSpanningForest(t *testing.T, g structures.Graph[string, int], forest []structures.Edge[string, int], total int) {
+	t.Helper()
+
+	ds := structures.NewDisjointSet[string]()
+	for _, vertex := range g.Vertices() {
+		ds.MakeSet(vertex)
+	}
+
+	sum := 0
+	for _, edge := range forest {
+		if weight, err := g.Weight(edge.From, edge.To); err != nil || weight != edge.Weight {
+			t.Fatalf("expected edge %v in the graph", edge)
+		}
+		if merged, _ := ds.Union(edge.From, edge.To); !merged {
+			t.Fatalf("edge %v closes a cycle in %v", edge, forest)
+		}
+		sum += edge.Weight
+	}
+	if sum != total {
+		t.Fatalf("expected total weight %d, got %d", sum, total)
+	}
+
+	for _, edge := range g.Edges() {
+		if !ds.Connected(edge.From, edge.To) {
+			t.Fatalf("expected %v and %v to be joined by the forest", edge.From, edge.To)
+		}
+	}
+}
+
+func TestSpanningForest(t *testing.T) {
+	for name, algorithm := range spanningForestAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A", "B", "C", "D", "E", "F", "G")
+				g.AddEdge("A", "B", 7)
+				g.AddEdge("A", "D", 5)
+				g.AddEdge("B", "C", 8)
+				g.AddEdge("B", "D", 9)
+				g.AddEdge("B", "E", 7)
+				g.AddEdge("C", "E", 5)
+				g.AddEdge("D", "E", 15)
+				g.AddEdge("D", "F", 6)
+				g.AddEdge("E", "F", 8)
+				g.AddEdge("E", "G", 9)
+				g.AddEdge("F", "G", 11)
+
+				forest, total, err := algorithm(g)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if total != 39 || len(forest) != 6 {
+					t.Fatalf("expected 6 edges weighing 39, got %v weighing %d", forest, total)
+				}
+				checkSpanningForest(t, g, forest, total)
+			})
+		})
+	}
+}
+
+func TestSpanningForest_Disconnected(t *testing.T) {
+	for name, algorithm := range spanningForestAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A", "B", "C", "D", "E")
+				g.AddEdge("A", "B", 1)
+				g.AddEdge("C", "D", 2)
+				g.AddEdge("D", "E", -3)
+				g.AddEdge("C", "E", 4)
+				g.AddEdge("C", "C", -5)
+
+				forest, total, err := algorithm(g)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if total != 0 || len(forest) != 3 {
+					t.Fatalf("expected 3 edges weighing 0, got %v weighing %d", forest, total)
+				}
+				checkSpanningForest(t, g, forest, total)
+			})
+		})
+	}
+}
+
+func TestSpanningForest_Directed(t *testing.T) {
+	for name, algorithm := range spanningForestAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A")
+
+				if _, _, err := algorithm(g); !errors.Is(err, structures.ErrDirectedGraph) {
+					t.Fatalf("expected ErrDirectedGraph, got %v", err)
+				}
+			})
+		})
+	}
+}
+
+func TestPrim_MissingStart(t *testing.T) {
+	g := structures.NewAdjacencyListGraph[string, int](false)
+	if _, _, err := structures.Prim(g, "Z"); !errors.Is(err, structures.ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound, got %v", err)
+	}
+}
+
+func TestSpanningForest_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		for _, constructor := range graphConstructors {
+			g := constructor.newGraph(false)
+			vertices := make([]string, 12)
+			for i := range vertices {
+				vertices[i] = string(rune('A' + i))
+				g.AddVertex(vertices[i])
+			}
+			for i := 0; i < 20; i++ {
+				g.AddEdge(vertices[rnd.Intn(12)], vertices[rnd.Intn(12)], rnd.Intn(20)-5)
+			}
+
+			kruskal, kruskalTotal, _ := structures.Kruskal(g)
+			prim, primTotal, _ := structures.Prim(g, vertices[rnd.Intn(12)])
+
+			checkSpanningForest(t, g, kruskal, kruskalTotal)
+			checkSpanningForest(t, g, prim, primTotal)
+			if kruskalTotal != primTotal || len(kruskal) != len(prim) {
+				t.Fatalf("%s: Kruskal found %v weighing %d, Prim %v weighing %d",
+					constructor.name, kruskal, kruskalTotal, prim, primTotal)
+			}
+		}
+	}
+}
@@ -31,9 +31,25 @@ func (n *TreeNode[T]) Children() []*TreeNode[T] {
 
 // SearchBinaryTreeNode represents a node in the search binary tree
 type SearchBinaryTreeNode[T any] struct {
-	value T
-	left  *SearchBinaryTreeNode[T]
-	right *SearchBinaryTreeNode[T]
+	value  T
+	left   *SearchBinaryTreeNode[T]
+	right  *SearchBinaryTreeNode[T]
+	height int // only maintained by self-balancing trees
+}
+
+// Value returns the value of the node
+func (n *SearchBinaryTreeNode[T]) Value() T {
+	return n.value
+}
+
+// Left returns the left child of the node, nil if there is none
+func (n *SearchBinaryTreeNode[T]) Left() *SearchBinaryTreeNode[T] {
+	return n.left
+}
+
+// Right returns the right child of the node, nil if there is none
+func (n *SearchBinaryTreeNode[T]) Right() *SearchBinaryTreeNode[T] {
+	return n.right
 }
 
 // Edge represents an edge in a graph with a source, destination, and weight.
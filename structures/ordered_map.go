@@ -0,0 +1,391 @@
+package structures
+
+import "errors"
+
+var (
+	ErrEmptyOrderedMap       = errors.New("empty ordered map")
+	ErrOrderedMapKeyNotFound = errors.New("ordered map key not found")
+)
+
+// orderedMapNode is a node of the left-leaning red-black tree of the ordered map
+type orderedMapNode[K comparable, V comparable] struct {
+	key   K
+	value V
+	left  *orderedMapNode[K, V]
+	right *orderedMapNode[K, V]
+	red   bool
+}
+
+// orderedMap is a dictionary that keeps its keys sorted, backed by a left-leaning
+// red-black tree so every operation is O(log n)
+type orderedMap[K comparable, V comparable] struct {
+	root    *orderedMapNode[K, V]
+	compare func(a, b K) int
+	size    int64
+}
+
+// NewOrderedMap creates a new empty ordered map sorted by the compare function
+func NewOrderedMap[K comparable, V comparable](compare func(a, b K) int) OrderedMapper[K, V] {
+	return &orderedMap[K, V]{compare: compare}
+}
+
+// Put adds a key-value pair to the map, replacing the value if the key exists
+func (m *orderedMap[K, V]) Put(key K, value V) {
+	m.root = m.put(m.root, key, value)
+	m.root.red = false
+}
+
+// put inserts the key-value pair on the subtree and returns its new root
+func (m *orderedMap[K, V]) put(node *orderedMapNode[K, V], key K, value V) *orderedMapNode[K, V] {
+	if node == nil {
+		m.size++
+		return &orderedMapNode[K, V]{key: key, value: value, red: true}
+	}
+
+	cmp := m.compare(key, node.key)
+	if cmp < 0 {
+		node.left = m.put(node.left, key, value)
+	} else if cmp > 0 {
+		node.right = m.put(node.right, key, value)
+	} else {
+		node.value = value
+	}
+
+	return balanceOrderedMapNode(node)
+}
+
+// Get retrieves the value associated with a key and whether the key exists
+func (m *orderedMap[K, V]) Get(key K) (V, bool) {
+	node := m.find(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// find retrieves the node with the key, nil if it does not exist
+func (m *orderedMap[K, V]) find(key K) *orderedMapNode[K, V] {
+	current := m.root
+	for current != nil {
+		cmp := m.compare(key, current.key)
+		if cmp == 0 {
+			return current
+		}
+
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return nil
+}
+
+// Delete removes a key from the map and returns whether it existed
+func (m *orderedMap[K, V]) Delete(key K) bool {
+	if m.find(key) == nil {
+		return false
+	}
+
+	if !isRedOrderedMapNode(m.root.left) && !isRedOrderedMapNode(m.root.right) {
+		m.root.red = true
+	}
+
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+
+	return true
+}
+
+// delete removes the key, which must exist, from the subtree and returns its new root
+func (m *orderedMap[K, V]) delete(node *orderedMapNode[K, V], key K) *orderedMapNode[K, V] {
+	if m.compare(key, node.key) < 0 {
+		if !isRedOrderedMapNode(node.left) && !isRedOrderedMapNode(node.left.left) {
+			node = moveRedLeftOrderedMapNode(node)
+		}
+		node.left = m.delete(node.left, key)
+		return balanceOrderedMapNode(node)
+	}
+
+	if isRedOrderedMapNode(node.left) {
+		node = rotateRightOrderedMapNode(node)
+	}
+
+	if m.compare(key, node.key) == 0 && node.right == nil {
+		return nil
+	}
+
+	if !isRedOrderedMapNode(node.right) && !isRedOrderedMapNode(node.right.left) {
+		node = moveRedRightOrderedMapNode(node)
+	}
+
+	if m.compare(key, node.key) == 0 {
+		successor := minOrderedMapNode(node.right)
+		node.key = successor.key
+		node.value = successor.value
+		node.right = deleteMinOrderedMapNode(node.right)
+	} else {
+		node.right = m.delete(node.right, key)
+	}
+
+	return balanceOrderedMapNode(node)
+}
+
+// Min retrieves the smallest key and its value
+// If map is empty, retrieve an ErrEmptyOrderedMap
+func (m *orderedMap[K, V]) Min() (K, V, error) {
+	if m.root == nil {
+		var k K
+		var v V
+		return k, v, ErrEmptyOrderedMap
+	}
+
+	node := minOrderedMapNode(m.root)
+	return node.key, node.value, nil
+}
+
+// Max retrieves the largest key and its value
+// If map is empty, retrieve an ErrEmptyOrderedMap
+func (m *orderedMap[K, V]) Max() (K, V, error) {
+	if m.root == nil {
+		var k K
+		var v V
+		return k, v, ErrEmptyOrderedMap
+	}
+
+	node := m.root
+	for node.right != nil {
+		node = node.right
+	}
+	return node.key, node.value, nil
+}
+
+// Floor retrieves the largest key lower than or equal to key and its value
+// If there is no such key, retrieve an ErrOrderedMapKeyNotFound
+func (m *orderedMap[K, V]) Floor(key K) (K, V, error) {
+	var found *orderedMapNode[K, V]
+
+	current := m.root
+	for current != nil {
+		cmp := m.compare(key, current.key)
+		if cmp == 0 {
+			return current.key, current.value, nil
+		}
+
+		if cmp < 0 {
+			current = current.left
+		} else {
+			found = current
+			current = current.right
+		}
+	}
+
+	if found == nil {
+		var k K
+		var v V
+		return k, v, ErrOrderedMapKeyNotFound
+	}
+	return found.key, found.value, nil
+}
+
+// Ceiling retrieves the smallest key greater than or equal to key and its value
+// If there is no such key, retrieve an ErrOrderedMapKeyNotFound
+func (m *orderedMap[K, V]) Ceiling(key K) (K, V, error) {
+	var found *orderedMapNode[K, V]
+
+	current := m.root
+	for current != nil {
+		cmp := m.compare(key, current.key)
+		if cmp == 0 {
+			return current.key, current.value, nil
+		}
+
+		if cmp > 0 {
+			current = current.right
+		} else {
+			found = current
+			current = current.left
+		}
+	}
+
+	if found == nil {
+		var k K
+		var v V
+		return k, v, ErrOrderedMapKeyNotFound
+	}
+	return found.key, found.value, nil
+}
+
+// Each calls fn for every key-value pair in ascending key order until fn returns false
+func (m *orderedMap[K, V]) Each(fn func(key K, value V) bool) {
+	var stack []*orderedMapNode[K, V]
+
+	current := m.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.left
+		}
+
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !fn(current.key, current.value) {
+			return
+		}
+
+		current = current.right
+	}
+}
+
+// Keys returns all keys in ascending order
+func (m *orderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// PushAt adds a key-value pair to the map, it is the same as Put
+func (m *orderedMap[K, V]) PushAt(key K, value V) {
+	m.Put(key, value)
+}
+
+// PopAt removes a key-value pair from the map and returns the value
+// If the key does not exist, retrieve an ErrHashMapKeyNotFound, like a hash map does
+func (m *orderedMap[K, V]) PopAt(key K) (V, error) {
+	value, exists := m.Get(key)
+	if !exists {
+		return value, ErrHashMapKeyNotFound
+	}
+
+	m.Delete(key)
+	return value, nil
+}
+
+// Has checks if a key exists in the map
+func (m *orderedMap[K, V]) Has(key K) bool {
+	return m.find(key) != nil
+}
+
+// GetAt retrieves the value associated with a key
+// If the key does not exist, retrieve an ErrHashMapKeyNotFound, like a hash map does
+func (m *orderedMap[K, V]) GetAt(key K) (V, error) {
+	value, exists := m.Get(key)
+	if !exists {
+		return value, ErrHashMapKeyNotFound
+	}
+	return value, nil
+}
+
+// Find searches for a value in the map
+func (m *orderedMap[K, V]) Find(value V) bool {
+	found := false
+	m.Each(func(_ K, v V) bool {
+		found = v == value
+		return !found
+	})
+	return found
+}
+
+// Size returns the number of key-value pairs in the map
+func (m *orderedMap[K, V]) Size() int64 {
+	return m.size
+}
+
+// isRedOrderedMapNode checks if the node is red, nil nodes are black
+func isRedOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) bool {
+	return node != nil && node.red
+}
+
+// rotateLeftOrderedMapNode turns a right-leaning red link to lean left
+func rotateLeftOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	right.red = node.red
+	node.red = true
+	return right
+}
+
+// rotateRightOrderedMapNode turns a left-leaning red link to lean right
+func rotateRightOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	left.red = node.red
+	node.red = true
+	return left
+}
+
+// flipColorsOrderedMapNode flips the colors of the node and its two children
+func flipColorsOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) {
+	node.red = !node.red
+	node.left.red = !node.left.red
+	node.right.red = !node.right.red
+}
+
+// balanceOrderedMapNode restores the left-leaning red-black invariants of the node
+func balanceOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	if isRedOrderedMapNode(node.right) && !isRedOrderedMapNode(node.left) {
+		node = rotateLeftOrderedMapNode(node)
+	}
+	if isRedOrderedMapNode(node.left) && isRedOrderedMapNode(node.left.left) {
+		node = rotateRightOrderedMapNode(node)
+	}
+	if isRedOrderedMapNode(node.left) && isRedOrderedMapNode(node.right) {
+		flipColorsOrderedMapNode(node)
+	}
+	return node
+}
+
+// moveRedLeftOrderedMapNode makes the left child or one of its children red,
+// assuming the node is red and both its children are black
+func moveRedLeftOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	flipColorsOrderedMapNode(node)
+	if isRedOrderedMapNode(node.right.left) {
+		node.right = rotateRightOrderedMapNode(node.right)
+		node = rotateLeftOrderedMapNode(node)
+		flipColorsOrderedMapNode(node)
+	}
+	return node
+}
+
+// moveRedRightOrderedMapNode makes the right child or one of its children red,
+// assuming the node is red and both its children are black
+func moveRedRightOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	flipColorsOrderedMapNode(node)
+	if isRedOrderedMapNode(node.left.left) {
+		node = rotateRightOrderedMapNode(node)
+		flipColorsOrderedMapNode(node)
+	}
+	return node
+}
+
+// minOrderedMapNode returns the node with the smallest key of the subtree
+func minOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+// deleteMinOrderedMapNode removes the smallest key of the subtree and returns its new root
+func deleteMinOrderedMapNode[K comparable, V comparable](node *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	if node.left == nil {
+		return nil
+	}
+
+	if !isRedOrderedMapNode(node.left) && !isRedOrderedMapNode(node.left.left) {
+		node = moveRedLeftOrderedMapNode(node)
+	}
+
+	node.left = deleteMinOrderedMapNode(node.left)
+	return balanceOrderedMapNode(node)
+}
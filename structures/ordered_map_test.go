@@ -0,0 +1,231 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewOrderedMap(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+	if m.Size() != 0 {
+		t.Errorf("Expected size 0, got %d", m.Size())
+	}
+}
+
+func TestOrderedMap_PutGet(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+	m.Put(2, "two")
+	m.Put(1, "one")
+	m.Put(3, "three")
+
+	if m.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", m.Size())
+	}
+
+	value, ok := m.Get(2)
+	if !ok || value != "two" {
+		t.Errorf("Expected two, got %v (exists: %v)", value, ok)
+	}
+
+	if _, ok := m.Get(4); ok {
+		t.Errorf("Expected key 4 to not exist")
+	}
+}
+
+func TestOrderedMap_Put_Overwrite(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+	m.Put(1, "one")
+	m.Put(1, "uno")
+
+	if m.Size() != 1 {
+		t.Errorf("Expected size 1, got %d", m.Size())
+	}
+
+	if value, _ := m.Get(1); value != "uno" {
+		t.Errorf("Expected uno, got %v", value)
+	}
+}
+
+func TestOrderedMap_Delete(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+	m.Put(1, "one")
+	m.Put(2, "two")
+
+	if !m.Delete(1) {
+		t.Errorf("Expected key 1 to be deleted")
+	}
+	if m.Delete(1) {
+		t.Errorf("Expected key 1 to not exist")
+	}
+	if m.Has(1) || !m.Has(2) || m.Size() != 1 {
+		t.Errorf("Expected only key 2, got keys %v", m.Keys())
+	}
+}
+
+func TestOrderedMap_MinMax(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+
+	if _, _, err := m.Min(); !errors.Is(err, structures.ErrEmptyOrderedMap) {
+		t.Errorf("Expected ErrEmptyOrderedMap, got %v", err)
+	}
+	if _, _, err := m.Max(); !errors.Is(err, structures.ErrEmptyOrderedMap) {
+		t.Errorf("Expected ErrEmptyOrderedMap, got %v", err)
+	}
+
+	for _, key := range []int{5, 3, 8, 1, 9} {
+		m.Put(key, "")
+	}
+
+	if key, _, _ := m.Min(); key != 1 {
+		t.Errorf("Expected min 1, got %v", key)
+	}
+	if key, _, _ := m.Max(); key != 9 {
+		t.Errorf("Expected max 9, got %v", key)
+	}
+}
+
+func TestOrderedMap_FloorCeiling(t *testing.T) {
+	m := structures.NewOrderedMap[int, string](compareInts)
+	for _, key := range []int{10, 20, 30} {
+		m.Put(key, "")
+	}
+
+	tests := []struct {
+		key        int
+		floor      int
+		floorErr   error
+		ceiling    int
+		ceilingErr error
+	}{
+		{key: 5, floorErr: structures.ErrOrderedMapKeyNotFound, ceiling: 10},
+		{key: 10, floor: 10, ceiling: 10},
+		{key: 15, floor: 10, ceiling: 20},
+		{key: 30, floor: 30, ceiling: 30},
+		{key: 35, floor: 30, ceilingErr: structures.ErrOrderedMapKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		floor, _, err := m.Floor(tt.key)
+		if !errors.Is(err, tt.floorErr) || (err == nil && floor != tt.floor) {
+			t.Errorf("Floor(%v): Expected %v (error: %v), got %v (error: %v)", tt.key, tt.floor, tt.floorErr, floor, err)
+		}
+
+		ceiling, _, err := m.Ceiling(tt.key)
+		if !errors.Is(err, tt.ceilingErr) || (err == nil && ceiling != tt.ceiling) {
+			t.Errorf("Ceiling(%v): Expected %v (error: %v), got %v (error: %v)", tt.key, tt.ceiling, tt.ceilingErr, ceiling, err)
+		}
+	}
+}
+
+func TestOrderedMap_Each(t *testing.T) {
+	m := structures.NewOrderedMap[string, int](strings.Compare)
+	m.Put("banana", 2)
+	m.Put("apple", 1)
+	m.Put("cherry", 3)
+
+	var keys []string
+	m.Each(func(key string, value int) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	expected := []string{"apple", "banana", "cherry"}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, keys)
+		}
+	}
+
+	count := 0
+	m.Each(func(key string, value int) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Expected Each to stop after 2 calls, got %d", count)
+	}
+}
+
+func TestOrderedMap_HashMapper(t *testing.T) {
+	mappers := map[string]structures.HashMapper[int, string]{
+		"hash map":    structures.NewHashMap[int, string](),
+		"ordered map": structures.NewOrderedMap[int, string](compareInts),
+	}
+
+	for name, hm := range mappers {
+		hm.PushAt(1, "one")
+		hm.PushAt(2, "two")
+
+		if !hm.Has(1) || !hm.Find("two") || hm.Find("three") {
+			t.Errorf("%s: unexpected Has or Find result", name)
+		}
+
+		if value, err := hm.GetAt(2); err != nil || value != "two" {
+			t.Errorf("%s: Expected two, got %v (error: %v)", name, value, err)
+		}
+
+		if value, err := hm.PopAt(1); err != nil || value != "one" {
+			t.Errorf("%s: Expected one, got %v (error: %v)", name, value, err)
+		}
+
+		if _, err := hm.PopAt(1); !errors.Is(err, structures.ErrHashMapKeyNotFound) {
+			t.Errorf("%s: Expected ErrHashMapKeyNotFound, got %v", name, err)
+		}
+
+		if _, err := hm.GetAt(1); !errors.Is(err, structures.ErrHashMapKeyNotFound) {
+			t.Errorf("%s: Expected ErrHashMapKeyNotFound, got %v", name, err)
+		}
+
+		if hm.Size() != 1 {
+			t.Errorf("%s: Expected size 1, got %d", name, hm.Size())
+		}
+	}
+}
+
+func TestOrderedMap_RandomOperations(t *testing.T) {
+	m := structures.NewOrderedMap[int, int](compareInts)
+	expected := make(map[int]int)
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		key := rnd.Intn(1000)
+		if rnd.Intn(3) == 0 {
+			_, exists := expected[key]
+			if m.Delete(key) != exists {
+				t.Fatalf("Delete(%v): Expected %v", key, exists)
+			}
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+
+	if m.Size() != int64(len(expected)) {
+		t.Fatalf("Expected size %d, got %d", len(expected), m.Size())
+	}
+
+	keys := make([]int, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	got := m.Keys()
+	if len(got) != len(keys) {
+		t.Fatalf("Expected %d keys, got %d", len(keys), len(got))
+	}
+	for i := range keys {
+		if got[i] != keys[i] {
+			t.Fatalf("Expected key %v at %d, got %v", keys[i], i, got[i])
+		}
+		if value, _ := m.Get(keys[i]); value != expected[keys[i]] {
+			t.Fatalf("Expected value %v for %v, got %v", expected[keys[i]], keys[i], value)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package structures
+
+import (
+	"errors"
+)
+
+const (
+	defaultRingQueueCapacity     = 16
+	defaultRingQueueGrowthFactor = 2
+)
+
+// ErrQueueFull tolds the fixed capacity queue is full
+var (
+	ErrQueueFull = errors.New("full queue")
+)
+
+// RingQueueOption configures a ring queue
+type RingQueueOption func(*ringQueueConfig)
+
+// ringQueueConfig stores the policies used by the ring queue
+type ringQueueConfig struct {
+	fixed        bool
+	growthFactor int
+	shrink       bool
+}
+
+// WithFixedCapacity makes the ring queue never grow. On a full queue, Push overwrites
+// the oldest element and TryPush fails with ErrQueueFull
+func WithFixedCapacity() RingQueueOption {
+	return func(c *ringQueueConfig) {
+		c.fixed = true
+	}
+}
+
+// WithGrowthFactor sets the factor the buffer is multiplied by when it is full.
+// Factors lower than 2 are ignored
+func WithGrowthFactor(factor int) RingQueueOption {
+	return func(c *ringQueueConfig) {
+		if factor >= 2 {
+			c.growthFactor = factor
+		}
+	}
+}
+
+// WithShrink allows the buffer to be halved when it is used a quarter or less,
+// never going below the initial capacity
+func WithShrink() RingQueueOption {
+	return func(c *ringQueueConfig) {
+		c.shrink = true
+	}
+}
+
+// ringQueue concrete implementation over a circular slice
+type ringQueue[T comparable] struct {
+	buffer          []T
+	head            int
+	size            int64
+	initialCapacity int
+	config          ringQueueConfig
+}
+
+// NewRingQueue retrieve an empty queue stored on a circular slice of the given capacity.
+// A capacity lower than 1 uses a default capacity. With WithFixedCapacity, pushing on a
+// full queue overwrites its oldest element, use TryPush to reject the new one instead
+func NewRingQueue[T comparable](capacity int, opts ...RingQueueOption) RingQueuer[T] {
+	if capacity < 1 {
+		capacity = defaultRingQueueCapacity
+	}
+
+	config := ringQueueConfig{growthFactor: defaultRingQueueGrowthFactor}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &ringQueue[T]{
+		buffer:          make([]T, capacity),
+		initialCapacity: capacity,
+		config:          config,
+	}
+}
+
+// Pop retrieves the element at the top of the queue
+// If queue is empty, retrieve an ErrEmptyQueue
+func (q *ringQueue[T]) Pop() (T, error) {
+	if q.size == 0 {
+		var t T
+		return t, ErrEmptyQueue
+	}
+
+	var zero T
+	topValue := q.buffer[q.head]
+	q.buffer[q.head] = zero // let the garbage collector reclaim the value
+	q.head = (q.head + 1) % len(q.buffer)
+	q.size--
+
+	if q.config.shrink && !q.config.fixed {
+		half := len(q.buffer) / 2
+		if q.size <= int64(len(q.buffer)/4) && half >= q.initialCapacity {
+			q.resize(half)
+		}
+	}
+
+	return topValue, nil
+}
+
+// Push allows to insert an element at the bottom of the queue
+// If the queue has a fixed capacity and it is full, the element at the top is dropped
+// to make room, use TryPush to handle that case
+func (q *ringQueue[T]) Push(value T) {
+	if err := q.TryPush(value); err != nil {
+		q.Pop()
+		q.TryPush(value)
+	}
+}
+
+// TryPush inserts an element at the bottom of the queue
+// If the queue has a fixed capacity and it is full, retrieve an ErrQueueFull
+func (q *ringQueue[T]) TryPush(value T) error {
+	if q.size == int64(len(q.buffer)) {
+		if q.config.fixed {
+			return ErrQueueFull
+		}
+		q.resize(len(q.buffer) * q.config.growthFactor)
+	}
+
+	q.buffer[(q.head+int(q.size))%len(q.buffer)] = value
+	q.size++
+
+	return nil
+}
+
+// resize moves the elements to a new buffer starting at index zero
+func (q *ringQueue[T]) resize(capacity int) {
+	buffer := make([]T, capacity)
+
+	if q.head+int(q.size) <= len(q.buffer) {
+		copy(buffer, q.buffer[q.head:q.head+int(q.size)])
+	} else {
+		n := copy(buffer, q.buffer[q.head:])
+		copy(buffer[n:], q.buffer[:int(q.size)-n])
+	}
+
+	q.buffer = buffer
+	q.head = 0
+}
+
+// Find check if an element exists on the queue
+func (q *ringQueue[T]) Find(value T) bool {
+	for i := 0; i < int(q.size); i++ {
+		if q.buffer[(q.head+i)%len(q.buffer)] == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Top retrieves a copy of the element at the top of the queue
+// If queue is empty, retrieve an ErrEmptyQueue
+func (q *ringQueue[T]) Top() (T, error) {
+	if q.size == 0 {
+		var t T
+		return t, ErrEmptyQueue
+	}
+
+	return q.buffer[q.head], nil
+}
+
+// Bottom retrieves a copy of the element at the bottom of the queue
+// If queue is empty, retrieve an ErrEmptyQueue
+func (q *ringQueue[T]) Bottom() (T, error) {
+	if q.size == 0 {
+		var t T
+		return t, ErrEmptyQueue
+	}
+
+	return q.buffer[(q.head+int(q.size)-1)%len(q.buffer)], nil
+}
+
+// Size retrieves the the quantity of elements on the queue
+func (q *ringQueue[T]) Size() int64 {
+	return q.size
+}
+
+// Capacity retrieves the quantity of elements the queue can hold before growing
+func (q *ringQueue[T]) Capacity() int {
+	return len(q.buffer)
+}
@@ -0,0 +1,309 @@
+package structures_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+func TestNewRingQueue(t *testing.T) {
+	q := structures.NewRingQueue[int](4)
+
+	if q.Size() != 0 {
+		t.Fatalf("NewRingQueue: Expected 0, got %v", q.Size())
+	}
+
+	if q.Capacity() != 4 {
+		t.Fatalf("NewRingQueue: Expected capacity 4, got %v", q.Capacity())
+	}
+}
+
+func TestNewRingQueue_DefaultCapacity(t *testing.T) {
+	q := structures.NewRingQueue[int](0)
+
+	if q.Capacity() < 1 {
+		t.Fatalf("NewRingQueue: Expected a positive capacity, got %v", q.Capacity())
+	}
+}
+
+func TestRingQueue_Push(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	if q.Size() != 3 {
+		t.Fatalf("RingQueue.Push: Expected 3, got %v", q.Size())
+	}
+
+	if q.Capacity() != 4 {
+		t.Fatalf("RingQueue.Push: Expected capacity 4, got %v", q.Capacity())
+	}
+}
+
+func TestRingQueue_Pop(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	for _, expected := range []int{1, 2, 3} {
+		v, err := q.Pop()
+		if err != nil {
+			t.Fatalf("RingQueue.Pop: Expected nil, got %v", err)
+		}
+
+		if v != expected {
+			t.Fatalf("RingQueue.Pop: Expected %v, got %v", expected, v)
+		}
+	}
+
+	if q.Size() != 0 {
+		t.Fatalf("RingQueue.Pop: Expected 0, got %v", q.Size())
+	}
+}
+
+func TestRingQueue_Pop_Empty(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	_, err := q.Pop()
+	if !errors.Is(err, structures.ErrEmptyQueue) {
+		t.Fatalf("RingQueue.Pop: Expected ErrEmptyQueue, got %v", err)
+	}
+}
+
+func TestRingQueue_WrapAround(t *testing.T) {
+	q := structures.NewRingQueue[int](4)
+
+	next := 0
+	expected := 0
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 3; i++ {
+			q.Push(next)
+			next++
+		}
+		for i := 0; i < 2; i++ {
+			v, err := q.Pop()
+			if err != nil {
+				t.Fatalf("RingQueue.Pop: Expected nil, got %v", err)
+			}
+			if v != expected {
+				t.Fatalf("RingQueue.Pop: Expected %v, got %v", expected, v)
+			}
+			expected++
+		}
+	}
+
+	for q.Size() > 0 {
+		v, _ := q.Pop()
+		if v != expected {
+			t.Fatalf("RingQueue.Pop: Expected %v, got %v", expected, v)
+		}
+		expected++
+	}
+
+	if expected != next {
+		t.Fatalf("RingQueue.Pop: Expected %v elements, got %v", next, expected)
+	}
+}
+
+func TestRingQueue_Top(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	v, err := q.Top()
+	if err != nil {
+		t.Fatalf("RingQueue.Top: Expected nil, got %v", err)
+	}
+
+	if v != 1 {
+		t.Fatalf("RingQueue.Top: Expected 1, got %v", v)
+	}
+}
+
+func TestRingQueue_Top_Empty(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	_, err := q.Top()
+	if !errors.Is(err, structures.ErrEmptyQueue) {
+		t.Fatalf("RingQueue.Top: Expected ErrEmptyQueue, got %v", err)
+	}
+}
+
+func TestRingQueue_Bottom(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	q.Push(1)
+	q.Pop()
+	q.Push(2)
+	q.Push(3)
+
+	v, err := q.Bottom()
+	if err != nil {
+		t.Fatalf("RingQueue.Bottom: Expected nil, got %v", err)
+	}
+
+	if v != 3 {
+		t.Fatalf("RingQueue.Bottom: Expected 3, got %v", v)
+	}
+}
+
+func TestRingQueue_Bottom_Empty(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	_, err := q.Bottom()
+	if !errors.Is(err, structures.ErrEmptyQueue) {
+		t.Fatalf("RingQueue.Bottom: Expected ErrEmptyQueue, got %v", err)
+	}
+}
+
+func TestRingQueue_Find(t *testing.T) {
+	q := structures.NewRingQueue[int](2)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	if !q.Find(3) {
+		t.Fatalf("RingQueue.Find: Expected true, got false")
+	}
+
+	if q.Find(4) {
+		t.Fatalf("RingQueue.Find: Expected false, got true")
+	}
+}
+
+func TestRingQueue_FixedCapacity(t *testing.T) {
+	q := structures.NewRingQueue[int](2, structures.WithFixedCapacity())
+
+	if err := q.TryPush(1); err != nil {
+		t.Fatalf("RingQueue.TryPush: Expected nil, got %v", err)
+	}
+	if err := q.TryPush(2); err != nil {
+		t.Fatalf("RingQueue.TryPush: Expected nil, got %v", err)
+	}
+
+	if err := q.TryPush(3); !errors.Is(err, structures.ErrQueueFull) {
+		t.Fatalf("RingQueue.TryPush: Expected ErrQueueFull, got %v", err)
+	}
+
+	if q.Capacity() != 2 || q.Size() != 2 {
+		t.Fatalf("RingQueue.TryPush: Expected capacity and size 2, got %v and %v", q.Capacity(), q.Size())
+	}
+}
+
+func TestRingQueue_FixedCapacity_PushOverwritesOldest(t *testing.T) {
+	q := structures.NewRingQueue[int](2, structures.WithFixedCapacity())
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	if q.Capacity() != 2 || q.Size() != 2 {
+		t.Fatalf("RingQueue.Push: Expected capacity and size 2, got %v and %v", q.Capacity(), q.Size())
+	}
+
+	top, _ := q.Top()
+	bottom, _ := q.Bottom()
+	if top != 2 || bottom != 3 {
+		t.Fatalf("RingQueue.Push: Expected top 2 and bottom 3, got %v and %v", top, bottom)
+	}
+}
+
+func TestRingQueue_GrowthFactor(t *testing.T) {
+	q := structures.NewRingQueue[int](2, structures.WithGrowthFactor(4))
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+
+	if q.Capacity() != 8 {
+		t.Fatalf("RingQueue.Push: Expected capacity 8, got %v", q.Capacity())
+	}
+}
+
+func TestRingQueue_Shrink(t *testing.T) {
+	q := structures.NewRingQueue[int](2, structures.WithShrink())
+
+	for i := 0; i < 16; i++ {
+		q.Push(i)
+	}
+
+	if q.Capacity() != 16 {
+		t.Fatalf("RingQueue.Push: Expected capacity 16, got %v", q.Capacity())
+	}
+
+	for i := 0; i < 15; i++ {
+		v, _ := q.Pop()
+		if v != i {
+			t.Fatalf("RingQueue.Pop: Expected %v, got %v", i, v)
+		}
+	}
+
+	if q.Capacity() != 2 {
+		t.Fatalf("RingQueue.Pop: Expected capacity 2, got %v", q.Capacity())
+	}
+
+	v, _ := q.Top()
+	if v != 15 {
+		t.Fatalf("RingQueue.Top: Expected 15, got %v", v)
+	}
+}
+
+func TestRingQueue_SatisfiesQueuer(t *testing.T) {
+	var q structures.Queuer[string] = structures.NewRingQueue[string](1)
+
+	q.Push("a")
+	q.Push("b")
+
+	v, _ := q.Pop()
+	if v != "a" {
+		t.Fatalf("RingQueue.Pop: Expected a, got %v", v)
+	}
+}
+
+func benchmarkQueuePushPop(b *testing.B, q structures.Queuer[int]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+		if q.Size() > 1024 {
+			q.Pop()
+		}
+	}
+}
+
+func BenchmarkQueue_PushPop(b *testing.B) {
+	benchmarkQueuePushPop(b, structures.NewQueue[int]())
+}
+
+func BenchmarkRingQueue_PushPop(b *testing.B) {
+	benchmarkQueuePushPop(b, structures.NewRingQueue[int](0))
+}
+
+func benchmarkQueueBurst(b *testing.B, newQueue func() structures.Queuer[int]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q := newQueue()
+		for j := 0; j < 1000; j++ {
+			q.Push(j)
+		}
+		for q.Size() > 0 {
+			q.Pop()
+		}
+	}
+}
+
+func BenchmarkQueue_Burst(b *testing.B) {
+	benchmarkQueueBurst(b, structures.NewQueue[int])
+}
+
+func BenchmarkRingQueue_Burst(b *testing.B) {
+	benchmarkQueueBurst(b, func() structures.Queuer[int] {
+		return structures.NewRingQueue[int](1024)
+	})
+}
@@ -0,0 +1,176 @@
+package structures
+
+import "slices"
+
+// StronglyConnectedComponents holds the components of a directed graph whose vertices
+// are all reachable from each other. Components are in topological order, so every
+// edge between two components goes from a lower index to a higher one
+type StronglyConnectedComponents[T comparable] struct {
+	// Components lists the vertices of every component
+	Components [][]T
+	// Membership maps every vertex to the index of its component
+	Membership map[T]int
+}
+
+// newStronglyConnectedComponents indexes the components by vertex
+func newStronglyConnectedComponents[T comparable](components [][]T) *StronglyConnectedComponents[T] {
+	membership := make(map[T]int)
+	for i, component := range components {
+		for _, vertex := range component {
+			membership[vertex] = i
+		}
+	}
+
+	return &StronglyConnectedComponents[T]{
+		Components: components,
+		Membership: membership,
+	}
+}
+
+// TarjanSCC finds the strongly connected components of a directed graph with
+// Tarjan's algorithm in a single depth-first search.
+// If the graph is undirected, retrieve an ErrUndirectedGraph
+func TarjanSCC[T comparable, W Numeric](g Graph[T, W]) (*StronglyConnectedComponents[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	index := make(map[T]int)
+	lowLink := make(map[T]int)
+	onStack := make(map[T]bool)
+	var stack []T
+	var frames []*postOrderFrame[T]
+	var components [][]T
+
+	visit := func(vertex T) {
+		index[vertex] = len(index)
+		lowLink[vertex] = index[vertex]
+		onStack[vertex] = true
+		stack = append(stack, vertex)
+
+		neighbors, _ := orderedNeighbors(g, vertex, nil)
+		frames = append(frames, &postOrderFrame[T]{vertex: vertex, neighbors: neighbors})
+	}
+
+	for _, root := range g.Vertices() {
+		if _, visited := index[root]; visited {
+			continue
+		}
+
+		visit(root)
+		for len(frames) > 0 {
+			frame := frames[len(frames)-1]
+			vertex := frame.vertex
+
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+
+				if _, visited := index[neighbor]; !visited {
+					visit(neighbor)
+				} else if onStack[neighbor] {
+					lowLink[vertex] = min(lowLink[vertex], index[neighbor])
+				}
+				continue
+			}
+
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].vertex
+				lowLink[parent] = min(lowLink[parent], lowLink[vertex])
+			}
+
+			if lowLink[vertex] != index[vertex] {
+				continue
+			}
+
+			var component []T
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[member] = false
+				component = append(component, member)
+				if member == vertex {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	// Tarjan's algorithm finishes the components in reverse topological order
+	slices.Reverse(components)
+	return newStronglyConnectedComponents(components), nil
+}
+
+// KosarajuSCC finds the strongly connected components of a directed graph with
+// Kosaraju's algorithm, searching the graph and then its transpose.
+// If the graph is undirected, retrieve an ErrUndirectedGraph
+func KosarajuSCC[T comparable, W Numeric](g Graph[T, W]) (*StronglyConnectedComponents[T], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	order, _ := depthFirstPostOrder(g, nil, false)
+	transposed := g.Transpose()
+
+	assigned := make(map[T]bool, len(order))
+	var components [][]T
+
+	for i := len(order) - 1; i >= 0; i-- {
+		root := order[i]
+		if assigned[root] {
+			continue
+		}
+
+		component := []T{root}
+		assigned[root] = true
+		for next := 0; next < len(component); next++ {
+			neighbors, _ := transposed.Neighbors(component[next])
+			for neighbor := range neighbors {
+				if !assigned[neighbor] {
+					assigned[neighbor] = true
+					component = append(component, neighbor)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+
+	return newStronglyConnectedComponents(components), nil
+}
+
+// Condensation builds the directed acyclic graph whose vertices are the strongly
+// connected components of g, numbered as in the returned components. The weights of
+// the edges joining the same two components are combined with aggregate, a nil
+// aggregate adds them up
+func Condensation[T comparable, W Numeric](g Graph[T, W], aggregate func(a, b W) W) (Graph[int, W], *StronglyConnectedComponents[T], error) {
+	scc, err := TarjanSCC(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if aggregate == nil {
+		aggregate = func(a, b W) W { return a + b }
+	}
+
+	condensed := NewAdjacencyListGraph[int, W](true)
+	for i := range scc.Components {
+		condensed.AddVertex(i)
+	}
+
+	for _, edge := range g.Edges() {
+		from, to := scc.Membership[edge.From], scc.Membership[edge.To]
+		if from == to {
+			continue
+		}
+
+		weight := edge.Weight
+		if current, err := condensed.Weight(from, to); err == nil {
+			weight = aggregate(current, weight)
+		}
+		condensed.AddEdge(from, to, weight)
+	}
+
+	return condensed, scc, nil
+}
@@ -0,0 +1,168 @@
+package structures_test
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// sccAlgorithms lists both strongly connected components algorithms
+var sccAlgorithms = map[string]func(structures.Graph[string, int]) (*structures.StronglyConnectedComponents[string], error){
+	"Tarjan":   structures.TarjanSCC[string, int],
+	"Kosaraju": structures.KosarajuSCC[string, int],
+}
+
+// sccGraph adds three components {A B C}, {D E} and {F} joined by A->D, C->D and E->F
+func sccGraph(t *testing.T, g structures.Graph[string, int]) {
+	t.Helper()
+	addVertices(t, g, "A", "B", "C", "D", "E", "F")
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 1)
+	g.AddEdge("C", "A", 1)
+	g.AddEdge("A", "D", 2)
+	g.AddEdge("C", "D", 3)
+	g.AddEdge("D", "E", 1)
+	g.AddEdge("E", "D", 1)
+	g.AddEdge("E", "F", 4)
+}
+
+// componentNames returns every component as its sorted vertices joined, in component order
+func componentNames(scc *structures.StronglyConnectedComponents[string]) []string {
+	var names []string
+	for _, component := range scc.Components {
+		vertices := append([]string(nil), component...)
+		sort.Strings(vertices)
+		names = append(names, strings.Join(vertices, ""))
+	}
+	return names
+}
+
+func TestSCC(t *testing.T) {
+	for name, algorithm := range sccAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				sccGraph(t, g)
+
+				scc, err := algorithm(g)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				names := componentNames(scc)
+				if strings.Join(names, " ") != "ABC DE F" {
+					t.Fatalf("expected components [ABC DE F] in topological order, got %v", names)
+				}
+
+				for i, component := range scc.Components {
+					for _, vertex := range component {
+						if scc.Membership[vertex] != i {
+							t.Fatalf("expected %v in component %d, got %d", vertex, i, scc.Membership[vertex])
+						}
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestSCC_RandomGraphs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		g := structures.NewAdjacencyListGraph[string, int](true)
+		vertices := make([]string, 12)
+		for i := range vertices {
+			vertices[i] = string(rune('a' + i))
+			g.AddVertex(vertices[i])
+		}
+		for i := 0; i < 18; i++ {
+			g.AddEdge(vertices[rnd.Intn(12)], vertices[rnd.Intn(12)], 1)
+		}
+
+		tarjan, _ := structures.TarjanSCC(g)
+		kosaraju, _ := structures.KosarajuSCC(g)
+
+		for _, a := range vertices {
+			for _, b := range vertices {
+				together := reaches(g, a, b) && reaches(g, b, a)
+				if (tarjan.Membership[a] == tarjan.Membership[b]) != together {
+					t.Fatalf("Tarjan: %v and %v together = %v, expected %v", a, b, !together, together)
+				}
+				if (kosaraju.Membership[a] == kosaraju.Membership[b]) != together {
+					t.Fatalf("Kosaraju: %v and %v together = %v, expected %v", a, b, !together, together)
+				}
+			}
+		}
+
+		for _, edge := range g.Edges() {
+			if tarjan.Membership[edge.From] > tarjan.Membership[edge.To] || kosaraju.Membership[edge.From] > kosaraju.Membership[edge.To] {
+				t.Fatalf("edge %v -> %v goes backwards between components", edge.From, edge.To)
+			}
+		}
+	}
+}
+
+// reaches checks if to can be reached from from
+func reaches(g structures.Graph[string, int], from, to string) bool {
+	found := false
+	structures.BFS(g, from, structures.Visitor[string]{
+		PreOrder: func(info structures.VisitInfo[string]) bool {
+			found = info.Vertex == to
+			return !found
+		},
+	})
+	return found
+}
+
+func TestSCC_Undirected(t *testing.T) {
+	for name, algorithm := range sccAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+				if _, err := algorithm(g); !errors.Is(err, structures.ErrUndirectedGraph) {
+					t.Fatalf("expected ErrUndirectedGraph, got %v", err)
+				}
+			})
+		})
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		sccGraph(t, g)
+
+		condensed, scc, err := structures.Condensation(g, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(condensed.Vertices()) != 3 || len(condensed.Edges()) != 2 {
+			t.Fatalf("expected 3 vertices and 2 edges, got %v and %v", condensed.Vertices(), condensed.Edges())
+		}
+		if structures.HasCycle(condensed) {
+			t.Fatalf("expected condensation to be acyclic")
+		}
+
+		abc, de, f := scc.Membership["A"], scc.Membership["D"], scc.Membership["F"]
+		if weight, _ := condensed.Weight(abc, de); weight != 5 {
+			t.Fatalf("expected summed weight 5, got %d", weight)
+		}
+		if weight, _ := condensed.Weight(de, f); weight != 4 {
+			t.Fatalf("expected weight 4, got %d", weight)
+		}
+	})
+}
+
+func TestCondensation_Aggregate(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		sccGraph(t, g)
+
+		condensed, scc, _ := structures.Condensation(g, func(a, b int) int { return min(a, b) })
+
+		if weight, _ := condensed.Weight(scc.Membership["B"], scc.Membership["E"]); weight != 2 {
+			t.Fatalf("expected lowest weight 2, got %d", weight)
+		}
+	})
+}
@@ -0,0 +1,224 @@
+package structures
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrCycleDetected   = errors.New("cycle detected")
+	ErrUndirectedGraph = errors.New("operation requires a directed graph")
+)
+
+// CycleError is the error returned when a cycle is found, it matches ErrCycleDetected
+type CycleError[T comparable] struct {
+	// Cycle lists the vertices of the cycle, starting and ending on the same vertex
+	Cycle []T
+}
+
+// Error describes the cycle
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCycleDetected, e.Cycle)
+}
+
+// Unwrap returns ErrCycleDetected
+func (e *CycleError[T]) Unwrap() error {
+	return ErrCycleDetected
+}
+
+// TopologicalSortOption configures a topological sort
+type TopologicalSortOption[T comparable] func(*topologicalSortConfig[T])
+
+// topologicalSortConfig stores the policies used by the topological sort
+type topologicalSortConfig[T comparable] struct {
+	compare func(a, b T) int
+}
+
+// WithLexicographicOrder makes the sort deterministic using compare to choose among
+// the vertices that can go next. Kahn's variant retrieves the lexicographically
+// smallest order, the depth-first variant only uses it to pick roots and neighbors
+func WithLexicographicOrder[T comparable](compare func(a, b T) int) TopologicalSortOption[T] {
+	return func(c *topologicalSortConfig[T]) {
+		c.compare = compare
+	}
+}
+
+// readyQueue holds the vertices that can go next in Kahn's algorithm, either in the order
+// they become ready or ordered by a priority
+type readyQueue[T comparable] interface {
+	Push(value T)
+	Pop() (T, error)
+	Size() int64
+}
+
+// TopologicalSort orders the vertices of a directed graph so every edge goes from an
+// earlier vertex to a later one, using Kahn's algorithm.
+// If the graph has a cycle, retrieve a *CycleError; if it is undirected, an ErrUndirectedGraph
+func TopologicalSort[T comparable, W Numeric](g Graph[T, W], opts ...TopologicalSortOption[T]) ([]T, error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	var config topologicalSortConfig[T]
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	vertices := orderedVertices(g, config.compare)
+	inDegrees := make(map[T]int, len(vertices))
+	for _, vertex := range vertices {
+		neighbors, _ := g.Neighbors(vertex)
+		for neighbor := range neighbors {
+			inDegrees[neighbor]++
+		}
+	}
+
+	// a plain queue keeps the vertices in the order they become ready
+	var ready readyQueue[T] = NewRingQueue[T](0)
+	if config.compare != nil {
+		ready = NewHeapPriorityQueue[T](func(a, b T) bool { return config.compare(a, b) < 0 })
+	}
+
+	for _, vertex := range vertices {
+		if inDegrees[vertex] == 0 {
+			ready.Push(vertex)
+		}
+	}
+
+	order := make([]T, 0, len(vertices))
+	for ready.Size() > 0 {
+		vertex, _ := ready.Pop()
+		order = append(order, vertex)
+
+		neighbors, _ := g.Neighbors(vertex)
+		for neighbor := range neighbors {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				ready.Push(neighbor)
+			}
+		}
+	}
+
+	if len(order) < len(vertices) {
+		_, cycle := depthFirstPostOrder(g, config.compare, true)
+		return nil, &CycleError[T]{Cycle: cycle}
+	}
+
+	return order, nil
+}
+
+// TopologicalSortDFS orders the vertices of a directed graph so every edge goes from an
+// earlier vertex to a later one, using the reverse post-order of a depth-first search.
+// If the graph has a cycle, retrieve a *CycleError; if it is undirected, an ErrUndirectedGraph
+func TopologicalSortDFS[T comparable, W Numeric](g Graph[T, W], opts ...TopologicalSortOption[T]) ([]T, error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	var config topologicalSortConfig[T]
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	order, cycle := depthFirstPostOrder(g, config.compare, true)
+	if cycle != nil {
+		return nil, &CycleError[T]{Cycle: cycle}
+	}
+
+	slices.Reverse(order)
+	return order, nil
+}
+
+// HasCycle checks if the graph has a cycle. In undirected graphs an edge walked back
+// to the vertex it came from is not a cycle, but a self-loop is
+func HasCycle[T comparable, W Numeric](g Graph[T, W]) bool {
+	_, cycle := depthFirstPostOrder(g, nil, true)
+	return cycle != nil
+}
+
+// postOrderFrame is a vertex on the stack of an iterative depth-first walk and its
+// pending neighbors
+type postOrderFrame[T comparable] struct {
+	vertex    T
+	neighbors []T
+	next      int
+}
+
+// depthFirstPostOrder walks the whole graph in depth-first order and returns its vertices
+// in post-order. When stopAtCycle is set, the first cycle found is returned instead
+func depthFirstPostOrder[T comparable, W Numeric](g Graph[T, W], compare func(a, b T) int, stopAtCycle bool) ([]T, []T) {
+	const (
+		unvisited = iota
+		onStack
+		finished
+	)
+
+	directed := g.IsDirected()
+	state := make(map[T]int)
+	position := make(map[T]int) // index on the stack of the vertices being explored
+	var order []T
+	var stack []*postOrderFrame[T]
+
+	push := func(vertex T) {
+		neighbors, _ := orderedNeighbors(g, vertex, compare)
+		state[vertex] = onStack
+		position[vertex] = len(stack)
+		stack = append(stack, &postOrderFrame[T]{vertex: vertex, neighbors: neighbors})
+	}
+
+	for _, root := range orderedVertices(g, compare) {
+		if state[root] != unvisited {
+			continue
+		}
+
+		push(root)
+		for len(stack) > 0 {
+			frame := stack[len(stack)-1]
+
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+
+				switch state[neighbor] {
+				case unvisited:
+					push(neighbor)
+				case onStack:
+					if !stopAtCycle {
+						continue
+					}
+
+					// the edge used to reach an undirected vertex is not a cycle
+					isParent := len(stack) > 1 && stack[len(stack)-2].vertex == neighbor
+					if !directed && isParent {
+						continue
+					}
+
+					cycle := make([]T, 0, len(stack)-position[neighbor]+1)
+					for _, f := range stack[position[neighbor]:] {
+						cycle = append(cycle, f.vertex)
+					}
+					return nil, append(cycle, neighbor)
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			state[frame.vertex] = finished
+			order = append(order, frame.vertex)
+		}
+	}
+
+	return order, nil
+}
+
+// orderedVertices returns the vertices of the graph, sorted when compare is set
+func orderedVertices[T comparable, W Numeric](g Graph[T, W], compare func(a, b T) int) []T {
+	vertices := g.Vertices()
+	if compare != nil {
+		// the graph may hand out its own slice, so sort a copy
+		vertices = slices.Clone(vertices)
+		slices.SortFunc(vertices, compare)
+	}
+	return vertices
+}
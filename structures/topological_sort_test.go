@@ -0,0 +1,199 @@
+package structures_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Jibaru/golang-data-structures/structures"
+)
+
+// topologicalSorts lists both topological sort variants
+var topologicalSorts = map[string]func(structures.Graph[string, int], ...structures.TopologicalSortOption[string]) ([]string, error){
+	"Kahn": structures.TopologicalSort[string, int],
+	"DFS":  structures.TopologicalSortDFS[string, int],
+}
+
+// pipelineGraph adds the steps of a build pipeline and their dependencies
+func pipelineGraph(t *testing.T, g structures.Graph[string, int]) {
+	t.Helper()
+	addVertices(t, g, "fetch", "lint", "compile", "test", "package", "docs")
+	g.AddEdge("fetch", "lint", 1)
+	g.AddEdge("fetch", "compile", 1)
+	g.AddEdge("compile", "test", 1)
+	g.AddEdge("lint", "test", 1)
+	g.AddEdge("test", "package", 1)
+	g.AddEdge("docs", "package", 1)
+}
+
+// checkTopologicalOrder fails the test if an edge goes backwards in the order
+func checkTopologicalOrder(t *testing.T, g structures.Graph[string, int], order []string) {
+	t.Helper()
+	if len(order) != len(g.Vertices()) {
+		t.Fatalf("expected %d vertices, got %v", len(g.Vertices()), order)
+	}
+
+	position := make(map[string]int)
+	for i, vertex := range order {
+		position[vertex] = i
+	}
+	for _, edge := range g.Edges() {
+		if position[edge.From] >= position[edge.To] {
+			t.Fatalf("edge %v -> %v goes backwards in %v", edge.From, edge.To, order)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	for name, sort := range topologicalSorts {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				pipelineGraph(t, g)
+
+				order, err := sort(g)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				checkTopologicalOrder(t, g, order)
+			})
+		})
+	}
+}
+
+func TestTopologicalSort_LexicographicOrder(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		pipelineGraph(t, g)
+
+		order, err := structures.TopologicalSort(g, structures.WithLexicographicOrder(strings.Compare))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expected := []string{"docs", "fetch", "compile", "lint", "test", "package"}
+		if strings.Join(order, " ") != strings.Join(expected, " ") {
+			t.Fatalf("expected %v, got %v", expected, order)
+		}
+	})
+}
+
+func TestTopologicalSortDFS_LexicographicOrder(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		pipelineGraph(t, g)
+
+		order, _ := structures.TopologicalSortDFS(g, structures.WithLexicographicOrder(strings.Compare))
+		again, _ := structures.TopologicalSortDFS(g, structures.WithLexicographicOrder(strings.Compare))
+
+		checkTopologicalOrder(t, g, order)
+		if strings.Join(order, " ") != strings.Join(again, " ") {
+			t.Fatalf("expected the same order twice, got %v and %v", order, again)
+		}
+	})
+}
+
+func TestTopologicalSort_Cycle(t *testing.T) {
+	for name, sort := range topologicalSorts {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				pipelineGraph(t, g)
+				g.AddEdge("test", "fetch", 1)
+
+				order, err := sort(g)
+				if !errors.Is(err, structures.ErrCycleDetected) {
+					t.Fatalf("expected ErrCycleDetected, got %v (order %v)", err, order)
+				}
+
+				var cycleErr *structures.CycleError[string]
+				if !errors.As(err, &cycleErr) {
+					t.Fatalf("expected a CycleError, got %T", err)
+				}
+
+				cycle := cycleErr.Cycle
+				if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+					t.Fatalf("expected a closed cycle, got %v", cycle)
+				}
+				for i := 0; i < len(cycle)-1; i++ {
+					if !g.HasEdge(cycle[i], cycle[i+1]) {
+						t.Fatalf("expected edge %v -> %v in cycle %v", cycle[i], cycle[i+1], cycle)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestTopologicalSort_SelfLoop(t *testing.T) {
+	for name, sort := range topologicalSorts {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A", "B")
+				g.AddEdge("A", "B", 1)
+				g.AddEdge("B", "B", 1)
+
+				var cycleErr *structures.CycleError[string]
+				if _, err := sort(g); !errors.As(err, &cycleErr) {
+					t.Fatalf("expected a CycleError, got %v", err)
+				}
+				if strings.Join(cycleErr.Cycle, " ") != "B B" {
+					t.Fatalf("expected cycle [B B], got %v", cycleErr.Cycle)
+				}
+			})
+		})
+	}
+}
+
+func TestTopologicalSort_Undirected(t *testing.T) {
+	for name, sort := range topologicalSorts {
+		t.Run(name, func(t *testing.T) {
+			forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+				addVertices(t, g, "A")
+
+				if _, err := sort(g); !errors.Is(err, structures.ErrUndirectedGraph) {
+					t.Fatalf("expected ErrUndirectedGraph, got %v", err)
+				}
+			})
+		})
+	}
+}
+
+func TestHasCycle_Directed(t *testing.T) {
+	forEachGraph(t, true, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "A", 1)
+
+		if !structures.HasCycle(g) {
+			t.Fatalf("expected A <-> B to be a cycle")
+		}
+
+		g.RemoveEdge("B", "A")
+		g.AddEdge("A", "C", 1)
+		g.AddEdge("B", "C", 1)
+		if structures.HasCycle(g) {
+			t.Fatalf("expected no cycle")
+		}
+	})
+}
+
+func TestHasCycle_Undirected(t *testing.T) {
+	forEachGraph(t, false, func(t *testing.T, g structures.Graph[string, int]) {
+		addVertices(t, g, "A", "B", "C", "D")
+		g.AddEdge("A", "B", 1)
+		g.AddEdge("B", "C", 1)
+		g.AddEdge("B", "D", 1)
+
+		if structures.HasCycle(g) {
+			t.Fatalf("expected a tree to have no cycle")
+		}
+
+		g.AddEdge("D", "C", 1)
+		if !structures.HasCycle(g) {
+			t.Fatalf("expected B-C-D to be a cycle")
+		}
+
+		g.RemoveEdge("D", "C")
+		g.AddEdge("A", "A", 1)
+		if !structures.HasCycle(g) {
+			t.Fatalf("expected a self-loop to be a cycle")
+		}
+	})
+}